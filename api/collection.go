@@ -72,6 +72,7 @@ func (*CollectionSvc) AddCollection(_ context.Context,
 		Location:      req.Location,
 		Disabled:      req.Disabled,
 		Remote:        req.Remote,
+		Watched:       req.Watched,
 		PerspectiveID: perspID,
 	}
 
@@ -157,6 +158,14 @@ func (*CollectionSvc) UpdateCollection(_ context.Context,
 		coll.Remote = true
 	}
 
+	if req.Unwatch {
+		coll.Watched = false
+	}
+
+	if req.Watch {
+		coll.Watched = true
+	}
+
 	if err := coll.Save(); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Error updating collection: %v", err)
@@ -179,8 +188,12 @@ func (*CollectionSvc) ScanCollection(_ context.Context,
 			"Collection not found: %v", err)
 	}
 
+	if coll.Disabled {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Cannot scan collection while disabled")
+	}
+
 	go func() {
-		coll.Verify()
 		coll.Scan(req.UpdateTags)
 	}()
 
@@ -194,7 +207,6 @@ func (*CollectionSvc) DiscoverCollections(_ context.Context,
 
 	go func() {
 		for _, coll := range s {
-			coll.Verify()
 			coll.Scan(false)
 		}
 	}()
@@ -202,6 +214,40 @@ func (*CollectionSvc) DiscoverCollections(_ context.Context,
 	return &m3uetcpb.Empty{}, nil
 }
 
+func (*CollectionSvc) ControlCollectionScan(_ context.Context,
+	req *m3uetcpb.ControlCollectionScanRequest) (*m3uetcpb.Empty, error) {
+
+	if req.Id < 1 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Collection ID must be greater than zero")
+	}
+
+	var action models.ScanAction
+	switch req.Action {
+	case m3uetcpb.ScanAction_SA_CANCEL:
+		action = models.ScanActionCancel
+	case m3uetcpb.ScanAction_SA_PAUSE:
+		action = models.ScanActionPause
+	case m3uetcpb.ScanAction_SA_RESUME:
+		action = models.ScanActionResume
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Unsupported scan action: %v", req.Action)
+	}
+
+	coll := models.Collection{}
+	if err := coll.Read(req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound,
+			"Collection not found: %v", err)
+	}
+
+	if err := models.ControlScan(coll.ID, action); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+
+	return &m3uetcpb.Empty{}, nil
+}
+
 func (*CollectionSvc) SubscribeToCollectionStore(_ *m3uetcpb.Empty,
 	stream m3uetcpb.CollectionSvc_SubscribeToCollectionStoreServer) error {
 
@@ -234,6 +280,19 @@ func (*CollectionSvc) SubscribeToCollectionStore(_ *m3uetcpb.Empty,
 		return stream.Send(res)
 	}
 
+	sendProgress := func(e m3uetcpb.CollectionEvent, data any) error {
+		res := &m3uetcpb.SubscribeToCollectionStoreResponse{
+			SubscriptionId: id,
+			Event:          e,
+		}
+		if sp, ok := data.(*models.ScanProgress); ok {
+			res.Item = &m3uetcpb.SubscribeToCollectionStoreResponse_Progress{
+				Progress: sp.ToProtobuf().(*m3uetcpb.ScanProgress),
+			}
+		}
+		return stream.Send(res)
+	}
+
 sLoop:
 	for {
 
@@ -246,11 +305,7 @@ sLoop:
 			if models.CollectionEvent(e.Idx) == models.CollectionEventInitial ||
 				models.CollectionEvent(e.Idx) == models.CollectionEventScanningDone {
 				if models.CollectionEvent(e.Idx) == models.CollectionEventScanningDone {
-					res := &m3uetcpb.SubscribeToCollectionStoreResponse{
-						SubscriptionId: id,
-						Event:          m3uetcpb.CollectionEvent_CE_SCANNING_DONE,
-					}
-					err := stream.Send(res)
+					err := sendProgress(m3uetcpb.CollectionEvent_CE_SCANNING_DONE, e.Data)
 					if err != nil {
 						return status.Errorf(codes.Internal,
 							"Error sending event (%v): %v",
@@ -303,11 +358,7 @@ sLoop:
 			}
 
 			if models.CollectionEvent(e.Idx) == models.CollectionEventScanning {
-				res := &m3uetcpb.SubscribeToCollectionStoreResponse{
-					SubscriptionId: id,
-					Event:          m3uetcpb.CollectionEvent_CE_SCANNING,
-				}
-				err := stream.Send(res)
+				err := sendProgress(m3uetcpb.CollectionEvent_CE_SCANNING, e.Data)
 				if err != nil {
 					return status.Errorf(codes.Internal,
 						"Error sending event (%v): %v",
@@ -316,6 +367,16 @@ sLoop:
 				continue sLoop
 			}
 
+			if models.CollectionEvent(e.Idx) == models.CollectionEventScanningProgress {
+				err := sendProgress(m3uetcpb.CollectionEvent_CE_SCANNING_PROGRESS, e.Data)
+				if err != nil {
+					return status.Errorf(codes.Internal,
+						"Error sending event (%v): %v",
+						m3uetcpb.CollectionEvent_CE_SCANNING_PROGRESS, err)
+				}
+				continue sLoop
+			}
+
 			var eout m3uetcpb.CollectionEvent
 			var fn func(m3uetcpb.CollectionEvent, models.ProtoOut) error
 
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"context"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// EqualizerSvc implements the m3uetcpb.EqualizerSvcServer interface.
+type EqualizerSvc struct {
+	m3uetcpb.UnimplementedEqualizerSvcServer
+	PbEvents playback.IEvents
+}
+
+func (*EqualizerSvc) GetEqualizerPresets(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.GetEqualizerPresetsResponse, error) {
+
+	eps := models.GetAllEqualizerPresets()
+
+	out := []*m3uetcpb.EqualizerPreset{}
+	for _, x := range eps {
+		out = append(out, x.ToProtobuf().(*m3uetcpb.EqualizerPreset))
+	}
+
+	pps := []*m3uetcpb.PerspectiveEqualizerPreset{}
+	for _, idx := range models.PerspectiveIndexList() {
+		bar, err := idx.GetPlaybar()
+		if err != nil || bar.EqualizerPresetID == 0 {
+			continue
+		}
+		pps = append(pps, &m3uetcpb.PerspectiveEqualizerPreset{
+			Perspective: m3uetcpb.Perspective(idx),
+			PresetId:    bar.EqualizerPresetID,
+		})
+	}
+
+	return &m3uetcpb.GetEqualizerPresetsResponse{
+		Presets:            out,
+		ActiveId:           models.GetPlaybackSettings().EqualizerPresetID,
+		PerspectivePresets: pps,
+	}, nil
+}
+
+func (svc *EqualizerSvc) AddEqualizerPreset(_ context.Context,
+	req *m3uetcpb.AddEqualizerPresetRequest) (*m3uetcpb.AddEqualizerPresetResponse, error) {
+
+	if req.Preset == nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"An equalizer preset is required")
+	}
+	if len(req.Preset.Bands) != models.EqualizerBandsCount {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"An equalizer preset must have exactly %d bands",
+			models.EqualizerBandsCount)
+	}
+
+	ep := models.EqualizerPresetFromProtobuf(req.Preset)
+	if err := ep.Save(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Error saving equalizer preset: %v", err)
+	}
+
+	if ep.Genre != "" {
+		svc.PbEvents.ReloadEqualizer()
+	}
+
+	return &m3uetcpb.AddEqualizerPresetResponse{Id: ep.ID}, nil
+}
+
+func (svc *EqualizerSvc) UpdateEqualizerPreset(_ context.Context,
+	req *m3uetcpb.UpdateEqualizerPresetRequest) (*m3uetcpb.Empty, error) {
+
+	if req.Preset == nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"An equalizer preset is required")
+	}
+	if len(req.Preset.Bands) != models.EqualizerBandsCount {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"An equalizer preset must have exactly %d bands",
+			models.EqualizerBandsCount)
+	}
+
+	ep := models.EqualizerPreset{}
+	if err := ep.Read(req.Preset.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	ep.FromProtobuf(req.Preset)
+	if err := ep.Save(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Error saving equalizer preset: %v", err)
+	}
+
+	svc.PbEvents.ReloadEqualizer()
+
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (svc *EqualizerSvc) RemoveEqualizerPreset(_ context.Context,
+	req *m3uetcpb.RemoveEqualizerPresetRequest) (*m3uetcpb.Empty, error) {
+
+	ep := models.EqualizerPreset{}
+	if err := ep.Read(req.Id); err != nil {
+		return nil, status.Errorf(codes.NotFound, "%v", err)
+	}
+
+	if err := ep.Delete(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	svc.PbEvents.ReloadEqualizer()
+
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (svc *EqualizerSvc) ActivateEqualizerPreset(_ context.Context,
+	req *m3uetcpb.ActivateEqualizerPresetRequest) (*m3uetcpb.Empty, error) {
+
+	if req.Id < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Equalizer preset id cannot be negative")
+	}
+
+	var err error
+	if req.Perspective != nil {
+		err = models.ActivateEqualizerPreset(req.Id,
+			models.PerspectiveIndex(*req.Perspective))
+	} else {
+		err = models.ActivateEqualizerPreset(req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound,
+			"Error activating equalizer preset: %v", err)
+	}
+
+	svc.PbEvents.ReloadEqualizer()
+
+	return &m3uetcpb.Empty{}, nil
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddEqualizerPreset(t *testing.T) {
+	table := []testCase{
+		{
+			"Add preset",
+			"api/equalizer/presets",
+			&m3uetcpb.AddEqualizerPresetRequest{
+				Preset: &m3uetcpb.EqualizerPreset{
+					Name:  "Loudness",
+					Bands: []float64{6, 4, 0, 0, -2, 0, -1, 0, 4, 6},
+				},
+			},
+			&m3uetcpb.AddEqualizerPresetResponse{},
+			false,
+		},
+		{
+			"Add preset with missing bands",
+			"api/equalizer/presets",
+			&m3uetcpb.AddEqualizerPresetRequest{
+				Preset: &m3uetcpb.EqualizerPreset{
+					Name:  "Loudness",
+					Bands: []float64{6, 4, 0},
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Add preset with gain out of range",
+			"api/equalizer/presets",
+			&m3uetcpb.AddEqualizerPresetRequest{
+				Preset: &m3uetcpb.EqualizerPreset{
+					Name:  "Loudness",
+					Bands: []float64{6, 4, 0, 0, -2, 0, -1, 0, 4, 20},
+				},
+			},
+			nil,
+			true,
+		},
+		{
+			"Add preset with existing name",
+			"api/equalizer/presets",
+			&m3uetcpb.AddEqualizerPresetRequest{
+				Preset: &m3uetcpb.EqualizerPreset{
+					Name:  "Rock",
+					Bands: make([]float64, models.EqualizerBandsCount),
+				},
+			},
+			nil,
+			true,
+		},
+	}
+
+	svc := EqualizerSvc{PbEvents: &pbEventsMock{}}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			res, err := svc.AddEqualizerPreset(context.Background(), tc.req.(*m3uetcpb.AddEqualizerPresetRequest))
+			assert.Equal(t, tc.wantErr, err != nil)
+			if tc.wantErr {
+				return
+			}
+
+			// IDs depend on the presets seeded along with the schema
+			req := tc.req.(*m3uetcpb.AddEqualizerPresetRequest)
+			ep := models.EqualizerPreset{}
+			assert.NoError(t, ep.Read(res.Id))
+			assert.Equal(t, req.Preset.Name, ep.Name)
+			assert.Equal(t, req.Preset.Bands, ep.Bands[:])
+		})
+	}
+}
+
+func TestActivateEqualizerPreset(t *testing.T) {
+	music := m3uetcpb.Perspective_MUSIC
+
+	table := []testCase{
+		{
+			"Activate preset globally",
+			"api/equalizer/presets",
+			&m3uetcpb.ActivateEqualizerPresetRequest{Id: 2},
+			&m3uetcpb.Empty{},
+			false,
+		},
+		{
+			"Activate preset for perspective",
+			"api/equalizer/presets",
+			&m3uetcpb.ActivateEqualizerPresetRequest{Id: 2, Perspective: &music},
+			&m3uetcpb.Empty{},
+			false,
+		},
+		{
+			"Activate flat",
+			"api/equalizer/presets",
+			&m3uetcpb.ActivateEqualizerPresetRequest{},
+			&m3uetcpb.Empty{},
+			false,
+		},
+		{
+			"Activate non-existing preset",
+			"api/equalizer/presets",
+			&m3uetcpb.ActivateEqualizerPresetRequest{Id: 100},
+			nil,
+			true,
+		},
+	}
+
+	svc := EqualizerSvc{PbEvents: &pbEventsMock{}}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			req := tc.req.(*m3uetcpb.ActivateEqualizerPresetRequest)
+			_, err := svc.ActivateEqualizerPreset(context.Background(), req)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if tc.wantErr {
+				return
+			}
+
+			res, err := svc.GetEqualizerPresets(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			if req.Perspective == nil {
+				assert.Equal(t, req.Id, res.ActiveId)
+				return
+			}
+			assert.Len(t, res.PerspectivePresets, 1)
+			assert.Equal(t, req.Id, res.PerspectivePresets[0].PresetId)
+		})
+	}
+}
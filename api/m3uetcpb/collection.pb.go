@@ -24,15 +24,16 @@ const (
 type CollectionEvent int32
 
 const (
-	CollectionEvent_CE_NONE          CollectionEvent = 0
-	CollectionEvent_CE_INITIAL       CollectionEvent = 1
-	CollectionEvent_CE_INITIAL_ITEM  CollectionEvent = 2
-	CollectionEvent_CE_INITIAL_DONE  CollectionEvent = 3
-	CollectionEvent_CE_ITEM_ADDED    CollectionEvent = 4
-	CollectionEvent_CE_ITEM_CHANGED  CollectionEvent = 5
-	CollectionEvent_CE_ITEM_REMOVED  CollectionEvent = 6
-	CollectionEvent_CE_SCANNING      CollectionEvent = 7
-	CollectionEvent_CE_SCANNING_DONE CollectionEvent = 8
+	CollectionEvent_CE_NONE              CollectionEvent = 0
+	CollectionEvent_CE_INITIAL           CollectionEvent = 1
+	CollectionEvent_CE_INITIAL_ITEM      CollectionEvent = 2
+	CollectionEvent_CE_INITIAL_DONE      CollectionEvent = 3
+	CollectionEvent_CE_ITEM_ADDED        CollectionEvent = 4
+	CollectionEvent_CE_ITEM_CHANGED      CollectionEvent = 5
+	CollectionEvent_CE_ITEM_REMOVED      CollectionEvent = 6
+	CollectionEvent_CE_SCANNING          CollectionEvent = 7
+	CollectionEvent_CE_SCANNING_DONE     CollectionEvent = 8
+	CollectionEvent_CE_SCANNING_PROGRESS CollectionEvent = 9
 )
 
 // Enum value maps for CollectionEvent.
@@ -47,17 +48,19 @@ var (
 		6: "CE_ITEM_REMOVED",
 		7: "CE_SCANNING",
 		8: "CE_SCANNING_DONE",
+		9: "CE_SCANNING_PROGRESS",
 	}
 	CollectionEvent_value = map[string]int32{
-		"CE_NONE":          0,
-		"CE_INITIAL":       1,
-		"CE_INITIAL_ITEM":  2,
-		"CE_INITIAL_DONE":  3,
-		"CE_ITEM_ADDED":    4,
-		"CE_ITEM_CHANGED":  5,
-		"CE_ITEM_REMOVED":  6,
-		"CE_SCANNING":      7,
-		"CE_SCANNING_DONE": 8,
+		"CE_NONE":              0,
+		"CE_INITIAL":           1,
+		"CE_INITIAL_ITEM":      2,
+		"CE_INITIAL_DONE":      3,
+		"CE_ITEM_ADDED":        4,
+		"CE_ITEM_CHANGED":      5,
+		"CE_ITEM_REMOVED":      6,
+		"CE_SCANNING":          7,
+		"CE_SCANNING_DONE":     8,
+		"CE_SCANNING_PROGRESS": 9,
 	}
 )
 
@@ -88,6 +91,58 @@ func (CollectionEvent) EnumDescriptor() ([]byte, []int) {
 	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{0}
 }
 
+type ScanAction int32
+
+const (
+	ScanAction_SA_NONE   ScanAction = 0
+	ScanAction_SA_CANCEL ScanAction = 1
+	ScanAction_SA_PAUSE  ScanAction = 2
+	ScanAction_SA_RESUME ScanAction = 3
+)
+
+// Enum value maps for ScanAction.
+var (
+	ScanAction_name = map[int32]string{
+		0: "SA_NONE",
+		1: "SA_CANCEL",
+		2: "SA_PAUSE",
+		3: "SA_RESUME",
+	}
+	ScanAction_value = map[string]int32{
+		"SA_NONE":   0,
+		"SA_CANCEL": 1,
+		"SA_PAUSE":  2,
+		"SA_RESUME": 3,
+	}
+)
+
+func (x ScanAction) Enum() *ScanAction {
+	p := new(ScanAction)
+	*p = x
+	return p
+}
+
+func (x ScanAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_m3uetcpb_collection_proto_enumTypes[1].Descriptor()
+}
+
+func (ScanAction) Type() protoreflect.EnumType {
+	return &file_api_m3uetcpb_collection_proto_enumTypes[1]
+}
+
+func (x ScanAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanAction.Descriptor instead.
+func (ScanAction) EnumDescriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{1}
+}
+
 type GetCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -240,6 +295,7 @@ type AddCollectionRequest struct {
 	Disabled    bool        `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	Remote      bool        `protobuf:"varint,5,opt,name=remote,proto3" json:"remote,omitempty"`
 	Perspective Perspective `protobuf:"varint,6,opt,name=perspective,proto3,enum=m3uetcpb.Perspective" json:"perspective,omitempty"`
+	Watched     bool        `protobuf:"varint,7,opt,name=watched,proto3" json:"watched,omitempty"`
 }
 
 func (x *AddCollectionRequest) Reset() {
@@ -316,6 +372,13 @@ func (x *AddCollectionRequest) GetPerspective() Perspective {
 	return Perspective_MUSIC
 }
 
+func (x *AddCollectionRequest) GetWatched() bool {
+	if x != nil {
+		return x.Watched
+	}
+	return false
+}
+
 type AddCollectionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -425,6 +488,8 @@ type UpdateCollectionRequest struct {
 	Disable             bool   `protobuf:"varint,8,opt,name=disable,proto3" json:"disable,omitempty"`
 	MakeRemote          bool   `protobuf:"varint,9,opt,name=make_remote,json=makeRemote,proto3" json:"make_remote,omitempty"`
 	MakeLocal           bool   `protobuf:"varint,10,opt,name=make_local,json=makeLocal,proto3" json:"make_local,omitempty"`
+	Watch               bool   `protobuf:"varint,11,opt,name=watch,proto3" json:"watch,omitempty"`
+	Unwatch             bool   `protobuf:"varint,12,opt,name=unwatch,proto3" json:"unwatch,omitempty"`
 }
 
 func (x *UpdateCollectionRequest) Reset() {
@@ -529,6 +594,20 @@ func (x *UpdateCollectionRequest) GetMakeLocal() bool {
 	return false
 }
 
+func (x *UpdateCollectionRequest) GetWatch() bool {
+	if x != nil {
+		return x.Watch
+	}
+	return false
+}
+
+func (x *UpdateCollectionRequest) GetUnwatch() bool {
+	if x != nil {
+		return x.Unwatch
+	}
+	return false
+}
+
 type ScanCollectionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -584,6 +663,61 @@ func (x *ScanCollectionRequest) GetUpdateTags() bool {
 	return false
 }
 
+type ControlCollectionScanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action ScanAction `protobuf:"varint,2,opt,name=action,proto3,enum=m3uetcpb.ScanAction" json:"action,omitempty"`
+}
+
+func (x *ControlCollectionScanRequest) Reset() {
+	*x = ControlCollectionScanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_collection_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControlCollectionScanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControlCollectionScanRequest) ProtoMessage() {}
+
+func (x *ControlCollectionScanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_collection_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ControlCollectionScanRequest.ProtoReflect.Descriptor instead.
+func (*ControlCollectionScanRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ControlCollectionScanRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ControlCollectionScanRequest) GetAction() ScanAction {
+	if x != nil {
+		return x.Action
+	}
+	return ScanAction_SA_NONE
+}
+
 type SubscribeToCollectionStoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -595,13 +729,14 @@ type SubscribeToCollectionStoreResponse struct {
 	//
 	//	*SubscribeToCollectionStoreResponse_Collection
 	//	*SubscribeToCollectionStoreResponse_Track
+	//	*SubscribeToCollectionStoreResponse_Progress
 	Item isSubscribeToCollectionStoreResponse_Item `protobuf_oneof:"item"`
 }
 
 func (x *SubscribeToCollectionStoreResponse) Reset() {
 	*x = SubscribeToCollectionStoreResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_m3uetcpb_collection_proto_msgTypes[8]
+		mi := &file_api_m3uetcpb_collection_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -614,7 +749,7 @@ func (x *SubscribeToCollectionStoreResponse) String() string {
 func (*SubscribeToCollectionStoreResponse) ProtoMessage() {}
 
 func (x *SubscribeToCollectionStoreResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_m3uetcpb_collection_proto_msgTypes[8]
+	mi := &file_api_m3uetcpb_collection_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -627,7 +762,7 @@ func (x *SubscribeToCollectionStoreResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use SubscribeToCollectionStoreResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeToCollectionStoreResponse) Descriptor() ([]byte, []int) {
-	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{8}
+	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SubscribeToCollectionStoreResponse) GetSubscriptionId() string {
@@ -665,6 +800,13 @@ func (x *SubscribeToCollectionStoreResponse) GetTrack() *Track {
 	return nil
 }
 
+func (x *SubscribeToCollectionStoreResponse) GetProgress() *ScanProgress {
+	if x, ok := x.GetItem().(*SubscribeToCollectionStoreResponse_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
 type isSubscribeToCollectionStoreResponse_Item interface {
 	isSubscribeToCollectionStoreResponse_Item()
 }
@@ -677,10 +819,16 @@ type SubscribeToCollectionStoreResponse_Track struct {
 	Track *Track `protobuf:"bytes,4,opt,name=track,proto3,oneof"`
 }
 
+type SubscribeToCollectionStoreResponse_Progress struct {
+	Progress *ScanProgress `protobuf:"bytes,5,opt,name=progress,proto3,oneof"`
+}
+
 func (*SubscribeToCollectionStoreResponse_Collection) isSubscribeToCollectionStoreResponse_Item() {}
 
 func (*SubscribeToCollectionStoreResponse_Track) isSubscribeToCollectionStoreResponse_Item() {}
 
+func (*SubscribeToCollectionStoreResponse_Progress) isSubscribeToCollectionStoreResponse_Item() {}
+
 type UnsubscribeFromCollectionStoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -692,7 +840,7 @@ type UnsubscribeFromCollectionStoreRequest struct {
 func (x *UnsubscribeFromCollectionStoreRequest) Reset() {
 	*x = UnsubscribeFromCollectionStoreRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_m3uetcpb_collection_proto_msgTypes[9]
+		mi := &file_api_m3uetcpb_collection_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -705,7 +853,7 @@ func (x *UnsubscribeFromCollectionStoreRequest) String() string {
 func (*UnsubscribeFromCollectionStoreRequest) ProtoMessage() {}
 
 func (x *UnsubscribeFromCollectionStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_m3uetcpb_collection_proto_msgTypes[9]
+	mi := &file_api_m3uetcpb_collection_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -718,7 +866,7 @@ func (x *UnsubscribeFromCollectionStoreRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UnsubscribeFromCollectionStoreRequest.ProtoReflect.Descriptor instead.
 func (*UnsubscribeFromCollectionStoreRequest) Descriptor() ([]byte, []int) {
-	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{9}
+	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UnsubscribeFromCollectionStoreRequest) GetSubscriptionId() string {
@@ -743,6 +891,7 @@ type Collection struct {
 	Scanned        int32                  `protobuf:"varint,8,opt,name=scanned,proto3" json:"scanned,omitempty"`
 	Tracks         int64                  `protobuf:"varint,9,opt,name=tracks,proto3" json:"tracks,omitempty"`
 	Perspective    Perspective            `protobuf:"varint,10,opt,name=perspective,proto3,enum=m3uetcpb.Perspective" json:"perspective,omitempty"`
+	Watched        bool                   `protobuf:"varint,11,opt,name=watched,proto3" json:"watched,omitempty"`
 	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
@@ -750,7 +899,7 @@ type Collection struct {
 func (x *Collection) Reset() {
 	*x = Collection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_m3uetcpb_collection_proto_msgTypes[10]
+		mi := &file_api_m3uetcpb_collection_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -763,7 +912,7 @@ func (x *Collection) String() string {
 func (*Collection) ProtoMessage() {}
 
 func (x *Collection) ProtoReflect() protoreflect.Message {
-	mi := &file_api_m3uetcpb_collection_proto_msgTypes[10]
+	mi := &file_api_m3uetcpb_collection_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +925,7 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Collection.ProtoReflect.Descriptor instead.
 func (*Collection) Descriptor() ([]byte, []int) {
-	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{10}
+	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Collection) GetId() int64 {
@@ -849,6 +998,13 @@ func (x *Collection) GetPerspective() Perspective {
 	return Perspective_MUSIC
 }
 
+func (x *Collection) GetWatched() bool {
+	if x != nil {
+		return x.Watched
+	}
+	return false
+}
+
 func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
@@ -863,6 +1019,141 @@ func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+type ScanProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollectionId int64  `protobuf:"varint,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	Total        int64  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Seen         int64  `protobuf:"varint,3,opt,name=seen,proto3" json:"seen,omitempty"`
+	Added        int64  `protobuf:"varint,4,opt,name=added,proto3" json:"added,omitempty"`
+	Updated      int64  `protobuf:"varint,5,opt,name=updated,proto3" json:"updated,omitempty"`
+	Unchanged    int64  `protobuf:"varint,6,opt,name=unchanged,proto3" json:"unchanged,omitempty"`
+	Removed      int64  `protobuf:"varint,7,opt,name=removed,proto3" json:"removed,omitempty"`
+	Failed       int64  `protobuf:"varint,8,opt,name=failed,proto3" json:"failed,omitempty"`
+	CurrentPath  string `protobuf:"bytes,9,opt,name=current_path,json=currentPath,proto3" json:"current_path,omitempty"`
+	Eta          int64  `protobuf:"varint,10,opt,name=eta,proto3" json:"eta,omitempty"` // in nanoseconds
+	Paused       bool   `protobuf:"varint,11,opt,name=paused,proto3" json:"paused,omitempty"`
+	Canceled     bool   `protobuf:"varint,12,opt,name=canceled,proto3" json:"canceled,omitempty"`
+}
+
+func (x *ScanProgress) Reset() {
+	*x = ScanProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_collection_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScanProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanProgress) ProtoMessage() {}
+
+func (x *ScanProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_collection_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanProgress.ProtoReflect.Descriptor instead.
+func (*ScanProgress) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_collection_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScanProgress) GetCollectionId() int64 {
+	if x != nil {
+		return x.CollectionId
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetSeen() int64 {
+	if x != nil {
+		return x.Seen
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetAdded() int64 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetUnchanged() int64 {
+	if x != nil {
+		return x.Unchanged
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetRemoved() int64 {
+	if x != nil {
+		return x.Removed
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetCurrentPath() string {
+	if x != nil {
+		return x.CurrentPath
+	}
+	return ""
+}
+
+func (x *ScanProgress) GetEta() int64 {
+	if x != nil {
+		return x.Eta
+	}
+	return 0
+}
+
+func (x *ScanProgress) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ScanProgress) GetCanceled() bool {
+	if x != nil {
+		return x.Canceled
+	}
+	return false
+}
+
 var File_api_m3uetcpb_collection_proto protoreflect.FileDescriptor
 
 var file_api_m3uetcpb_collection_proto_rawDesc = []byte{
@@ -888,7 +1179,7 @@ var file_api_m3uetcpb_collection_proto_rawDesc = []byte{
 	0x73, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
 	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x14, 0x41,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x14, 0x41,
 	0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
@@ -902,145 +1193,192 @@ var file_api_m3uetcpb_collection_proto_rawDesc = []byte{
 	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
 	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x70, 0x65,
 	0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x22, 0x27, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0xf0, 0x02, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x72, 0x65, 0x73, 0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x6e, 0x65, 0x77, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x13, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c,
-	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6b,
-	0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x6d, 0x61, 0x6b, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61,
-	0x6b, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x6d, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x63, 0x61,
-	0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
-	0x61, 0x67, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x22, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
-	0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
-	0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x33,
-	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x50, 0x0a,
-	0x25, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22,
-	0xac, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73,
-	0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
-	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
-	0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
-	0x50, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72,
-	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0xbc,
-	0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01, 0x12,
-	0x13, 0x0a, 0x0f, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x49, 0x54,
-	0x45, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49,
-	0x41, 0x4c, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x45, 0x5f,
-	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f,
-	0x43, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10,
-	0x05, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x52, 0x45, 0x4d,
-	0x4f, 0x56, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x45, 0x5f, 0x53, 0x43, 0x41,
-	0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x45, 0x5f, 0x53, 0x43,
-	0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x08, 0x32, 0xce, 0x05,
-	0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x76, 0x63, 0x12,
-	0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1e, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x22, 0x27, 0x0a, 0x15,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x29, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
 	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43,
-	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
-	0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0d,
-	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e,
-	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x33, 0x75,
-	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
-	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x42,
-	0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e,
-	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0xa0, 0x03, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08,
+	0x6e, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6e, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6e, 0x65, 0x77, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x13, 0x6e, 0x65, 0x77, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6e, 0x65, 0x77, 0x52,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a,
+	0x15, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x61, 0x6b, 0x65, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x6b, 0x65, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x61, 0x6b, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x77, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x6e, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x61, 0x67, 0x73, 0x22, 0x5c, 0x0a,
+	0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x02, 0x0a, 0x22,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75,
+	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x34, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x22, 0x50, 0x0a, 0x25, 0x55,
+	0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc6, 0x03,
+	0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4c,
+	0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x63,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x37, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x65,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xc6, 0x02, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6e, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x74, 0x61,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x2a,
+	0xd6, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00,
+	0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x10, 0x01,
+	0x12, 0x13, 0x0a, 0x0f, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x49,
+	0x54, 0x45, 0x4d, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54,
+	0x49, 0x41, 0x4c, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x45,
+	0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a,
+	0x0f, 0x43, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44,
+	0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x45, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x52, 0x45,
+	0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x45, 0x5f, 0x53, 0x43,
+	0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x45, 0x5f, 0x53,
+	0x43, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0x08, 0x12, 0x18,
+	0x0a, 0x14, 0x43, 0x45, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x52,
+	0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x09, 0x2a, 0x45, 0x0a, 0x0a, 0x53, 0x63, 0x61, 0x6e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x41, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x45, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x41, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x41, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x10, 0x03, 0x32,
+	0xa0, 0x06, 0x0a, 0x0d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x76,
+	0x63, 0x12, 0x50, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x23, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x46, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x46, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x42, 0x0a, 0x0e, 0x53, 0x63, 0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x63,
+	0x61, 0x6e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x26, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
+	0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
+	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x5d, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0f, 0x2e,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c,
+	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x62,
+	0x0a, 0x1e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x2f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x37, 0x0a, 0x13, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x6f,
-	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65,
-	0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75,
-	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5d, 0x0a, 0x1a, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65,
-	0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x6d, 0x33, 0x75,
-	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
-	0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x62, 0x0a, 0x1e, 0x55, 0x6e,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x2f, 0x2e, 0x6d,
-	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
-	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0c,
-	0x5a, 0x0a, 0x2e, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x79, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1055,59 +1393,66 @@ func file_api_m3uetcpb_collection_proto_rawDescGZIP() []byte {
 	return file_api_m3uetcpb_collection_proto_rawDescData
 }
 
-var file_api_m3uetcpb_collection_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_m3uetcpb_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_m3uetcpb_collection_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_m3uetcpb_collection_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_m3uetcpb_collection_proto_goTypes = []interface{}{
 	(CollectionEvent)(0),                          // 0: m3uetcpb.CollectionEvent
-	(*GetCollectionRequest)(nil),                  // 1: m3uetcpb.GetCollectionRequest
-	(*GetCollectionResponse)(nil),                 // 2: m3uetcpb.GetCollectionResponse
-	(*GetAllCollectionsResponse)(nil),             // 3: m3uetcpb.GetAllCollectionsResponse
-	(*AddCollectionRequest)(nil),                  // 4: m3uetcpb.AddCollectionRequest
-	(*AddCollectionResponse)(nil),                 // 5: m3uetcpb.AddCollectionResponse
-	(*RemoveCollectionRequest)(nil),               // 6: m3uetcpb.RemoveCollectionRequest
-	(*UpdateCollectionRequest)(nil),               // 7: m3uetcpb.UpdateCollectionRequest
-	(*ScanCollectionRequest)(nil),                 // 8: m3uetcpb.ScanCollectionRequest
-	(*SubscribeToCollectionStoreResponse)(nil),    // 9: m3uetcpb.SubscribeToCollectionStoreResponse
-	(*UnsubscribeFromCollectionStoreRequest)(nil), // 10: m3uetcpb.UnsubscribeFromCollectionStoreRequest
-	(*Collection)(nil),                            // 11: m3uetcpb.Collection
-	(Perspective)(0),                              // 12: m3uetcpb.Perspective
-	(*Track)(nil),                                 // 13: m3uetcpb.Track
-	(*timestamppb.Timestamp)(nil),                 // 14: google.protobuf.Timestamp
-	(*Empty)(nil),                                 // 15: m3uetcpb.Empty
+	(ScanAction)(0),                               // 1: m3uetcpb.ScanAction
+	(*GetCollectionRequest)(nil),                  // 2: m3uetcpb.GetCollectionRequest
+	(*GetCollectionResponse)(nil),                 // 3: m3uetcpb.GetCollectionResponse
+	(*GetAllCollectionsResponse)(nil),             // 4: m3uetcpb.GetAllCollectionsResponse
+	(*AddCollectionRequest)(nil),                  // 5: m3uetcpb.AddCollectionRequest
+	(*AddCollectionResponse)(nil),                 // 6: m3uetcpb.AddCollectionResponse
+	(*RemoveCollectionRequest)(nil),               // 7: m3uetcpb.RemoveCollectionRequest
+	(*UpdateCollectionRequest)(nil),               // 8: m3uetcpb.UpdateCollectionRequest
+	(*ScanCollectionRequest)(nil),                 // 9: m3uetcpb.ScanCollectionRequest
+	(*ControlCollectionScanRequest)(nil),          // 10: m3uetcpb.ControlCollectionScanRequest
+	(*SubscribeToCollectionStoreResponse)(nil),    // 11: m3uetcpb.SubscribeToCollectionStoreResponse
+	(*UnsubscribeFromCollectionStoreRequest)(nil), // 12: m3uetcpb.UnsubscribeFromCollectionStoreRequest
+	(*Collection)(nil),                            // 13: m3uetcpb.Collection
+	(*ScanProgress)(nil),                          // 14: m3uetcpb.ScanProgress
+	(Perspective)(0),                              // 15: m3uetcpb.Perspective
+	(*Track)(nil),                                 // 16: m3uetcpb.Track
+	(*timestamppb.Timestamp)(nil),                 // 17: google.protobuf.Timestamp
+	(*Empty)(nil),                                 // 18: m3uetcpb.Empty
 }
 var file_api_m3uetcpb_collection_proto_depIdxs = []int32{
-	11, // 0: m3uetcpb.GetCollectionResponse.collection:type_name -> m3uetcpb.Collection
-	11, // 1: m3uetcpb.GetAllCollectionsResponse.collections:type_name -> m3uetcpb.Collection
-	12, // 2: m3uetcpb.AddCollectionRequest.perspective:type_name -> m3uetcpb.Perspective
-	0,  // 3: m3uetcpb.SubscribeToCollectionStoreResponse.event:type_name -> m3uetcpb.CollectionEvent
-	11, // 4: m3uetcpb.SubscribeToCollectionStoreResponse.collection:type_name -> m3uetcpb.Collection
-	13, // 5: m3uetcpb.SubscribeToCollectionStoreResponse.track:type_name -> m3uetcpb.Track
-	12, // 6: m3uetcpb.Collection.perspective:type_name -> m3uetcpb.Perspective
-	14, // 7: m3uetcpb.Collection.created_at:type_name -> google.protobuf.Timestamp
-	14, // 8: m3uetcpb.Collection.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: m3uetcpb.CollectionSvc.GetCollection:input_type -> m3uetcpb.GetCollectionRequest
-	15, // 10: m3uetcpb.CollectionSvc.GetAllCollections:input_type -> m3uetcpb.Empty
-	4,  // 11: m3uetcpb.CollectionSvc.AddCollection:input_type -> m3uetcpb.AddCollectionRequest
-	6,  // 12: m3uetcpb.CollectionSvc.RemoveCollection:input_type -> m3uetcpb.RemoveCollectionRequest
-	7,  // 13: m3uetcpb.CollectionSvc.UpdateCollection:input_type -> m3uetcpb.UpdateCollectionRequest
-	8,  // 14: m3uetcpb.CollectionSvc.ScanCollection:input_type -> m3uetcpb.ScanCollectionRequest
-	15, // 15: m3uetcpb.CollectionSvc.DiscoverCollections:input_type -> m3uetcpb.Empty
-	15, // 16: m3uetcpb.CollectionSvc.SubscribeToCollectionStore:input_type -> m3uetcpb.Empty
-	10, // 17: m3uetcpb.CollectionSvc.UnsubscribeFromCollectionStore:input_type -> m3uetcpb.UnsubscribeFromCollectionStoreRequest
-	2,  // 18: m3uetcpb.CollectionSvc.GetCollection:output_type -> m3uetcpb.GetCollectionResponse
-	3,  // 19: m3uetcpb.CollectionSvc.GetAllCollections:output_type -> m3uetcpb.GetAllCollectionsResponse
-	5,  // 20: m3uetcpb.CollectionSvc.AddCollection:output_type -> m3uetcpb.AddCollectionResponse
-	15, // 21: m3uetcpb.CollectionSvc.RemoveCollection:output_type -> m3uetcpb.Empty
-	15, // 22: m3uetcpb.CollectionSvc.UpdateCollection:output_type -> m3uetcpb.Empty
-	15, // 23: m3uetcpb.CollectionSvc.ScanCollection:output_type -> m3uetcpb.Empty
-	15, // 24: m3uetcpb.CollectionSvc.DiscoverCollections:output_type -> m3uetcpb.Empty
-	9,  // 25: m3uetcpb.CollectionSvc.SubscribeToCollectionStore:output_type -> m3uetcpb.SubscribeToCollectionStoreResponse
-	15, // 26: m3uetcpb.CollectionSvc.UnsubscribeFromCollectionStore:output_type -> m3uetcpb.Empty
-	18, // [18:27] is the sub-list for method output_type
-	9,  // [9:18] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	13, // 0: m3uetcpb.GetCollectionResponse.collection:type_name -> m3uetcpb.Collection
+	13, // 1: m3uetcpb.GetAllCollectionsResponse.collections:type_name -> m3uetcpb.Collection
+	15, // 2: m3uetcpb.AddCollectionRequest.perspective:type_name -> m3uetcpb.Perspective
+	1,  // 3: m3uetcpb.ControlCollectionScanRequest.action:type_name -> m3uetcpb.ScanAction
+	0,  // 4: m3uetcpb.SubscribeToCollectionStoreResponse.event:type_name -> m3uetcpb.CollectionEvent
+	13, // 5: m3uetcpb.SubscribeToCollectionStoreResponse.collection:type_name -> m3uetcpb.Collection
+	16, // 6: m3uetcpb.SubscribeToCollectionStoreResponse.track:type_name -> m3uetcpb.Track
+	14, // 7: m3uetcpb.SubscribeToCollectionStoreResponse.progress:type_name -> m3uetcpb.ScanProgress
+	15, // 8: m3uetcpb.Collection.perspective:type_name -> m3uetcpb.Perspective
+	17, // 9: m3uetcpb.Collection.created_at:type_name -> google.protobuf.Timestamp
+	17, // 10: m3uetcpb.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 11: m3uetcpb.CollectionSvc.GetCollection:input_type -> m3uetcpb.GetCollectionRequest
+	18, // 12: m3uetcpb.CollectionSvc.GetAllCollections:input_type -> m3uetcpb.Empty
+	5,  // 13: m3uetcpb.CollectionSvc.AddCollection:input_type -> m3uetcpb.AddCollectionRequest
+	7,  // 14: m3uetcpb.CollectionSvc.RemoveCollection:input_type -> m3uetcpb.RemoveCollectionRequest
+	8,  // 15: m3uetcpb.CollectionSvc.UpdateCollection:input_type -> m3uetcpb.UpdateCollectionRequest
+	9,  // 16: m3uetcpb.CollectionSvc.ScanCollection:input_type -> m3uetcpb.ScanCollectionRequest
+	18, // 17: m3uetcpb.CollectionSvc.DiscoverCollections:input_type -> m3uetcpb.Empty
+	10, // 18: m3uetcpb.CollectionSvc.ControlCollectionScan:input_type -> m3uetcpb.ControlCollectionScanRequest
+	18, // 19: m3uetcpb.CollectionSvc.SubscribeToCollectionStore:input_type -> m3uetcpb.Empty
+	12, // 20: m3uetcpb.CollectionSvc.UnsubscribeFromCollectionStore:input_type -> m3uetcpb.UnsubscribeFromCollectionStoreRequest
+	3,  // 21: m3uetcpb.CollectionSvc.GetCollection:output_type -> m3uetcpb.GetCollectionResponse
+	4,  // 22: m3uetcpb.CollectionSvc.GetAllCollections:output_type -> m3uetcpb.GetAllCollectionsResponse
+	6,  // 23: m3uetcpb.CollectionSvc.AddCollection:output_type -> m3uetcpb.AddCollectionResponse
+	18, // 24: m3uetcpb.CollectionSvc.RemoveCollection:output_type -> m3uetcpb.Empty
+	18, // 25: m3uetcpb.CollectionSvc.UpdateCollection:output_type -> m3uetcpb.Empty
+	18, // 26: m3uetcpb.CollectionSvc.ScanCollection:output_type -> m3uetcpb.Empty
+	18, // 27: m3uetcpb.CollectionSvc.DiscoverCollections:output_type -> m3uetcpb.Empty
+	18, // 28: m3uetcpb.CollectionSvc.ControlCollectionScan:output_type -> m3uetcpb.Empty
+	11, // 29: m3uetcpb.CollectionSvc.SubscribeToCollectionStore:output_type -> m3uetcpb.SubscribeToCollectionStoreResponse
+	18, // 30: m3uetcpb.CollectionSvc.UnsubscribeFromCollectionStore:output_type -> m3uetcpb.Empty
+	21, // [21:31] is the sub-list for method output_type
+	11, // [11:21] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_api_m3uetcpb_collection_proto_init() }
@@ -1216,7 +1561,7 @@ func file_api_m3uetcpb_collection_proto_init() {
 			}
 		}
 		file_api_m3uetcpb_collection_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeToCollectionStoreResponse); i {
+			switch v := v.(*ControlCollectionScanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1228,7 +1573,7 @@ func file_api_m3uetcpb_collection_proto_init() {
 			}
 		}
 		file_api_m3uetcpb_collection_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnsubscribeFromCollectionStoreRequest); i {
+			switch v := v.(*SubscribeToCollectionStoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1240,6 +1585,18 @@ func file_api_m3uetcpb_collection_proto_init() {
 			}
 		}
 		file_api_m3uetcpb_collection_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeFromCollectionStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_collection_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Collection); i {
 			case 0:
 				return &v.state
@@ -1251,18 +1608,31 @@ func file_api_m3uetcpb_collection_proto_init() {
 				return nil
 			}
 		}
+		file_api_m3uetcpb_collection_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScanProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_m3uetcpb_collection_proto_msgTypes[8].OneofWrappers = []interface{}{
+	file_api_m3uetcpb_collection_proto_msgTypes[9].OneofWrappers = []interface{}{
 		(*SubscribeToCollectionStoreResponse_Collection)(nil),
 		(*SubscribeToCollectionStoreResponse_Track)(nil),
+		(*SubscribeToCollectionStoreResponse_Progress)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_m3uetcpb_collection_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
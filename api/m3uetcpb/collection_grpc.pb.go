@@ -29,6 +29,7 @@ type CollectionSvcClient interface {
 	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Empty, error)
 	ScanCollection(ctx context.Context, in *ScanCollectionRequest, opts ...grpc.CallOption) (*Empty, error)
 	DiscoverCollections(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ControlCollectionScan(ctx context.Context, in *ControlCollectionScanRequest, opts ...grpc.CallOption) (*Empty, error)
 	SubscribeToCollectionStore(ctx context.Context, in *Empty, opts ...grpc.CallOption) (CollectionSvc_SubscribeToCollectionStoreClient, error)
 	UnsubscribeFromCollectionStore(ctx context.Context, in *UnsubscribeFromCollectionStoreRequest, opts ...grpc.CallOption) (*Empty, error)
 }
@@ -104,6 +105,15 @@ func (c *collectionSvcClient) DiscoverCollections(ctx context.Context, in *Empty
 	return out, nil
 }
 
+func (c *collectionSvcClient) ControlCollectionScan(ctx context.Context, in *ControlCollectionScanRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.CollectionSvc/ControlCollectionScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *collectionSvcClient) SubscribeToCollectionStore(ctx context.Context, in *Empty, opts ...grpc.CallOption) (CollectionSvc_SubscribeToCollectionStoreClient, error) {
 	stream, err := c.cc.NewStream(ctx, &CollectionSvc_ServiceDesc.Streams[0], "/m3uetcpb.CollectionSvc/SubscribeToCollectionStore", opts...)
 	if err != nil {
@@ -156,6 +166,7 @@ type CollectionSvcServer interface {
 	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Empty, error)
 	ScanCollection(context.Context, *ScanCollectionRequest) (*Empty, error)
 	DiscoverCollections(context.Context, *Empty) (*Empty, error)
+	ControlCollectionScan(context.Context, *ControlCollectionScanRequest) (*Empty, error)
 	SubscribeToCollectionStore(*Empty, CollectionSvc_SubscribeToCollectionStoreServer) error
 	UnsubscribeFromCollectionStore(context.Context, *UnsubscribeFromCollectionStoreRequest) (*Empty, error)
 	mustEmbedUnimplementedCollectionSvcServer()
@@ -186,6 +197,9 @@ func (UnimplementedCollectionSvcServer) ScanCollection(context.Context, *ScanCol
 func (UnimplementedCollectionSvcServer) DiscoverCollections(context.Context, *Empty) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DiscoverCollections not implemented")
 }
+func (UnimplementedCollectionSvcServer) ControlCollectionScan(context.Context, *ControlCollectionScanRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlCollectionScan not implemented")
+}
 func (UnimplementedCollectionSvcServer) SubscribeToCollectionStore(*Empty, CollectionSvc_SubscribeToCollectionStoreServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToCollectionStore not implemented")
 }
@@ -331,6 +345,24 @@ func _CollectionSvc_DiscoverCollections_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionSvc_ControlCollectionScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlCollectionScanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionSvcServer).ControlCollectionScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.CollectionSvc/ControlCollectionScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionSvcServer).ControlCollectionScan(ctx, req.(*ControlCollectionScanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CollectionSvc_SubscribeToCollectionStore_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -405,6 +437,10 @@ var CollectionSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DiscoverCollections",
 			Handler:    _CollectionSvc_DiscoverCollections_Handler,
 		},
+		{
+			MethodName: "ControlCollectionScan",
+			Handler:    _CollectionSvc_ControlCollectionScan_Handler,
+		},
 		{
 			MethodName: "UnsubscribeFromCollectionStore",
 			Handler:    _CollectionSvc_UnsubscribeFromCollectionStore_Handler,
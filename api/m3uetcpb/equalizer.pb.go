@@ -0,0 +1,758 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.12
+// source: api/m3uetcpb/equalizer.proto
+
+package m3uetcpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetEqualizerPresetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Presets            []*EqualizerPreset            `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+	ActiveId           int64                         `protobuf:"varint,2,opt,name=active_id,json=activeId,proto3" json:"active_id,omitempty"`
+	PerspectivePresets []*PerspectiveEqualizerPreset `protobuf:"bytes,3,rep,name=perspective_presets,json=perspectivePresets,proto3" json:"perspective_presets,omitempty"`
+}
+
+func (x *GetEqualizerPresetsResponse) Reset() {
+	*x = GetEqualizerPresetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEqualizerPresetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEqualizerPresetsResponse) ProtoMessage() {}
+
+func (x *GetEqualizerPresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEqualizerPresetsResponse.ProtoReflect.Descriptor instead.
+func (*GetEqualizerPresetsResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetEqualizerPresetsResponse) GetPresets() []*EqualizerPreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+func (x *GetEqualizerPresetsResponse) GetActiveId() int64 {
+	if x != nil {
+		return x.ActiveId
+	}
+	return 0
+}
+
+func (x *GetEqualizerPresetsResponse) GetPerspectivePresets() []*PerspectiveEqualizerPreset {
+	if x != nil {
+		return x.PerspectivePresets
+	}
+	return nil
+}
+
+type AddEqualizerPresetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset *EqualizerPreset `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+}
+
+func (x *AddEqualizerPresetRequest) Reset() {
+	*x = AddEqualizerPresetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEqualizerPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEqualizerPresetRequest) ProtoMessage() {}
+
+func (x *AddEqualizerPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEqualizerPresetRequest.ProtoReflect.Descriptor instead.
+func (*AddEqualizerPresetRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddEqualizerPresetRequest) GetPreset() *EqualizerPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+type AddEqualizerPresetResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *AddEqualizerPresetResponse) Reset() {
+	*x = AddEqualizerPresetResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddEqualizerPresetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddEqualizerPresetResponse) ProtoMessage() {}
+
+func (x *AddEqualizerPresetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddEqualizerPresetResponse.ProtoReflect.Descriptor instead.
+func (*AddEqualizerPresetResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddEqualizerPresetResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateEqualizerPresetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Preset *EqualizerPreset `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
+}
+
+func (x *UpdateEqualizerPresetRequest) Reset() {
+	*x = UpdateEqualizerPresetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateEqualizerPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEqualizerPresetRequest) ProtoMessage() {}
+
+func (x *UpdateEqualizerPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEqualizerPresetRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEqualizerPresetRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateEqualizerPresetRequest) GetPreset() *EqualizerPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return nil
+}
+
+type RemoveEqualizerPresetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RemoveEqualizerPresetRequest) Reset() {
+	*x = RemoveEqualizerPresetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveEqualizerPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveEqualizerPresetRequest) ProtoMessage() {}
+
+func (x *RemoveEqualizerPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveEqualizerPresetRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEqualizerPresetRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemoveEqualizerPresetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ActivateEqualizerPresetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Perspective *Perspective `protobuf:"varint,2,opt,name=perspective,proto3,enum=m3uetcpb.Perspective,oneof" json:"perspective,omitempty"`
+}
+
+func (x *ActivateEqualizerPresetRequest) Reset() {
+	*x = ActivateEqualizerPresetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActivateEqualizerPresetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateEqualizerPresetRequest) ProtoMessage() {}
+
+func (x *ActivateEqualizerPresetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateEqualizerPresetRequest.ProtoReflect.Descriptor instead.
+func (*ActivateEqualizerPresetRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ActivateEqualizerPresetRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivateEqualizerPresetRequest) GetPerspective() Perspective {
+	if x != nil && x.Perspective != nil {
+		return *x.Perspective
+	}
+	return Perspective_MUSIC
+}
+
+type PerspectiveEqualizerPreset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Perspective Perspective `protobuf:"varint,1,opt,name=perspective,proto3,enum=m3uetcpb.Perspective" json:"perspective,omitempty"`
+	PresetId    int64       `protobuf:"varint,2,opt,name=preset_id,json=presetId,proto3" json:"preset_id,omitempty"`
+}
+
+func (x *PerspectiveEqualizerPreset) Reset() {
+	*x = PerspectiveEqualizerPreset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PerspectiveEqualizerPreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PerspectiveEqualizerPreset) ProtoMessage() {}
+
+func (x *PerspectiveEqualizerPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PerspectiveEqualizerPreset.ProtoReflect.Descriptor instead.
+func (*PerspectiveEqualizerPreset) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PerspectiveEqualizerPreset) GetPerspective() Perspective {
+	if x != nil {
+		return x.Perspective
+	}
+	return Perspective_MUSIC
+}
+
+func (x *PerspectiveEqualizerPreset) GetPresetId() int64 {
+	if x != nil {
+		return x.PresetId
+	}
+	return 0
+}
+
+type EqualizerPreset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Bands       []float64              `protobuf:"fixed64,4,rep,packed,name=bands,proto3" json:"bands,omitempty"`
+	Genre       string                 `protobuf:"bytes,5,opt,name=genre,proto3" json:"genre,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *EqualizerPreset) Reset() {
+	*x = EqualizerPreset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EqualizerPreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EqualizerPreset) ProtoMessage() {}
+
+func (x *EqualizerPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_equalizer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EqualizerPreset.ProtoReflect.Descriptor instead.
+func (*EqualizerPreset) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_equalizer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EqualizerPreset) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EqualizerPreset) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EqualizerPreset) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *EqualizerPreset) GetBands() []float64 {
+	if x != nil {
+		return x.Bands
+	}
+	return nil
+}
+
+func (x *EqualizerPreset) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *EqualizerPreset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EqualizerPreset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_api_m3uetcpb_equalizer_proto protoreflect.FileDescriptor
+
+var file_api_m3uetcpb_equalizer_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2f, 0x65,
+	0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
+	0x62, 0x2f, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52,
+	0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x13, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x12, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x19,
+	0x41, 0x64, 0x64, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x2c, 0x0a, 0x1a,
+	0x41, 0x64, 0x64, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x51, 0x0a, 0x1c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75,
+	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x06, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x22, 0x2e, 0x0a,
+	0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x7e, 0x0a,
+	0x1e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x3c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x70,
+	0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x72, 0x0a,
+	0x1a, 0x50, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x45, 0x71, 0x75, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x70,
+	0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x65, 0x74, 0x49,
+	0x64, 0x22, 0xf9, 0x01, 0x0a, 0x0f, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62,
+	0x61, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x62, 0x61, 0x6e, 0x64,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0xb8, 0x03,
+	0x0a, 0x0c, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x53, 0x76, 0x63, 0x12, 0x4d,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a,
+	0x12, 0x41, 0x64, 0x64, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65,
+	0x73, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x26, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x12, 0x50, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x26, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x45, 0x71, 0x75, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x54, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x45, 0x71,
+	0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x28, 0x2e,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x45, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x50, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_m3uetcpb_equalizer_proto_rawDescOnce sync.Once
+	file_api_m3uetcpb_equalizer_proto_rawDescData = file_api_m3uetcpb_equalizer_proto_rawDesc
+)
+
+func file_api_m3uetcpb_equalizer_proto_rawDescGZIP() []byte {
+	file_api_m3uetcpb_equalizer_proto_rawDescOnce.Do(func() {
+		file_api_m3uetcpb_equalizer_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_m3uetcpb_equalizer_proto_rawDescData)
+	})
+	return file_api_m3uetcpb_equalizer_proto_rawDescData
+}
+
+var file_api_m3uetcpb_equalizer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_m3uetcpb_equalizer_proto_goTypes = []interface{}{
+	(*GetEqualizerPresetsResponse)(nil),    // 0: m3uetcpb.GetEqualizerPresetsResponse
+	(*AddEqualizerPresetRequest)(nil),      // 1: m3uetcpb.AddEqualizerPresetRequest
+	(*AddEqualizerPresetResponse)(nil),     // 2: m3uetcpb.AddEqualizerPresetResponse
+	(*UpdateEqualizerPresetRequest)(nil),   // 3: m3uetcpb.UpdateEqualizerPresetRequest
+	(*RemoveEqualizerPresetRequest)(nil),   // 4: m3uetcpb.RemoveEqualizerPresetRequest
+	(*ActivateEqualizerPresetRequest)(nil), // 5: m3uetcpb.ActivateEqualizerPresetRequest
+	(*PerspectiveEqualizerPreset)(nil),     // 6: m3uetcpb.PerspectiveEqualizerPreset
+	(*EqualizerPreset)(nil),                // 7: m3uetcpb.EqualizerPreset
+	(Perspective)(0),                       // 8: m3uetcpb.Perspective
+	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
+	(*Empty)(nil),                          // 10: m3uetcpb.Empty
+}
+var file_api_m3uetcpb_equalizer_proto_depIdxs = []int32{
+	7,  // 0: m3uetcpb.GetEqualizerPresetsResponse.presets:type_name -> m3uetcpb.EqualizerPreset
+	6,  // 1: m3uetcpb.GetEqualizerPresetsResponse.perspective_presets:type_name -> m3uetcpb.PerspectiveEqualizerPreset
+	7,  // 2: m3uetcpb.AddEqualizerPresetRequest.preset:type_name -> m3uetcpb.EqualizerPreset
+	7,  // 3: m3uetcpb.UpdateEqualizerPresetRequest.preset:type_name -> m3uetcpb.EqualizerPreset
+	8,  // 4: m3uetcpb.ActivateEqualizerPresetRequest.perspective:type_name -> m3uetcpb.Perspective
+	8,  // 5: m3uetcpb.PerspectiveEqualizerPreset.perspective:type_name -> m3uetcpb.Perspective
+	9,  // 6: m3uetcpb.EqualizerPreset.created_at:type_name -> google.protobuf.Timestamp
+	9,  // 7: m3uetcpb.EqualizerPreset.updated_at:type_name -> google.protobuf.Timestamp
+	10, // 8: m3uetcpb.EqualizerSvc.GetEqualizerPresets:input_type -> m3uetcpb.Empty
+	1,  // 9: m3uetcpb.EqualizerSvc.AddEqualizerPreset:input_type -> m3uetcpb.AddEqualizerPresetRequest
+	3,  // 10: m3uetcpb.EqualizerSvc.UpdateEqualizerPreset:input_type -> m3uetcpb.UpdateEqualizerPresetRequest
+	4,  // 11: m3uetcpb.EqualizerSvc.RemoveEqualizerPreset:input_type -> m3uetcpb.RemoveEqualizerPresetRequest
+	5,  // 12: m3uetcpb.EqualizerSvc.ActivateEqualizerPreset:input_type -> m3uetcpb.ActivateEqualizerPresetRequest
+	0,  // 13: m3uetcpb.EqualizerSvc.GetEqualizerPresets:output_type -> m3uetcpb.GetEqualizerPresetsResponse
+	2,  // 14: m3uetcpb.EqualizerSvc.AddEqualizerPreset:output_type -> m3uetcpb.AddEqualizerPresetResponse
+	10, // 15: m3uetcpb.EqualizerSvc.UpdateEqualizerPreset:output_type -> m3uetcpb.Empty
+	10, // 16: m3uetcpb.EqualizerSvc.RemoveEqualizerPreset:output_type -> m3uetcpb.Empty
+	10, // 17: m3uetcpb.EqualizerSvc.ActivateEqualizerPreset:output_type -> m3uetcpb.Empty
+	13, // [13:18] is the sub-list for method output_type
+	8,  // [8:13] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_m3uetcpb_equalizer_proto_init() }
+func file_api_m3uetcpb_equalizer_proto_init() {
+	if File_api_m3uetcpb_equalizer_proto != nil {
+		return
+	}
+	file_api_m3uetcpb_empty_proto_init()
+	file_api_m3uetcpb_perspective_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_api_m3uetcpb_equalizer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEqualizerPresetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEqualizerPresetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEqualizerPresetResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEqualizerPresetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveEqualizerPresetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActivateEqualizerPresetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PerspectiveEqualizerPreset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_equalizer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EqualizerPreset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_api_m3uetcpb_equalizer_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_m3uetcpb_equalizer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_m3uetcpb_equalizer_proto_goTypes,
+		DependencyIndexes: file_api_m3uetcpb_equalizer_proto_depIdxs,
+		MessageInfos:      file_api_m3uetcpb_equalizer_proto_msgTypes,
+	}.Build()
+	File_api_m3uetcpb_equalizer_proto = out.File
+	file_api_m3uetcpb_equalizer_proto_rawDesc = nil
+	file_api_m3uetcpb_equalizer_proto_goTypes = nil
+	file_api_m3uetcpb_equalizer_proto_depIdxs = nil
+}
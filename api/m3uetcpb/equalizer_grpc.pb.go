@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: api/m3uetcpb/equalizer.proto
+
+package m3uetcpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EqualizerSvcClient is the client API for EqualizerSvc service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EqualizerSvcClient interface {
+	GetEqualizerPresets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetEqualizerPresetsResponse, error)
+	AddEqualizerPreset(ctx context.Context, in *AddEqualizerPresetRequest, opts ...grpc.CallOption) (*AddEqualizerPresetResponse, error)
+	UpdateEqualizerPreset(ctx context.Context, in *UpdateEqualizerPresetRequest, opts ...grpc.CallOption) (*Empty, error)
+	RemoveEqualizerPreset(ctx context.Context, in *RemoveEqualizerPresetRequest, opts ...grpc.CallOption) (*Empty, error)
+	ActivateEqualizerPreset(ctx context.Context, in *ActivateEqualizerPresetRequest, opts ...grpc.CallOption) (*Empty, error)
+}
+
+type equalizerSvcClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEqualizerSvcClient(cc grpc.ClientConnInterface) EqualizerSvcClient {
+	return &equalizerSvcClient{cc}
+}
+
+func (c *equalizerSvcClient) GetEqualizerPresets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetEqualizerPresetsResponse, error) {
+	out := new(GetEqualizerPresetsResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.EqualizerSvc/GetEqualizerPresets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *equalizerSvcClient) AddEqualizerPreset(ctx context.Context, in *AddEqualizerPresetRequest, opts ...grpc.CallOption) (*AddEqualizerPresetResponse, error) {
+	out := new(AddEqualizerPresetResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.EqualizerSvc/AddEqualizerPreset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *equalizerSvcClient) UpdateEqualizerPreset(ctx context.Context, in *UpdateEqualizerPresetRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.EqualizerSvc/UpdateEqualizerPreset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *equalizerSvcClient) RemoveEqualizerPreset(ctx context.Context, in *RemoveEqualizerPresetRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.EqualizerSvc/RemoveEqualizerPreset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *equalizerSvcClient) ActivateEqualizerPreset(ctx context.Context, in *ActivateEqualizerPresetRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.EqualizerSvc/ActivateEqualizerPreset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EqualizerSvcServer is the server API for EqualizerSvc service.
+// All implementations must embed UnimplementedEqualizerSvcServer
+// for forward compatibility
+type EqualizerSvcServer interface {
+	GetEqualizerPresets(context.Context, *Empty) (*GetEqualizerPresetsResponse, error)
+	AddEqualizerPreset(context.Context, *AddEqualizerPresetRequest) (*AddEqualizerPresetResponse, error)
+	UpdateEqualizerPreset(context.Context, *UpdateEqualizerPresetRequest) (*Empty, error)
+	RemoveEqualizerPreset(context.Context, *RemoveEqualizerPresetRequest) (*Empty, error)
+	ActivateEqualizerPreset(context.Context, *ActivateEqualizerPresetRequest) (*Empty, error)
+	mustEmbedUnimplementedEqualizerSvcServer()
+}
+
+// UnimplementedEqualizerSvcServer must be embedded to have forward compatible implementations.
+type UnimplementedEqualizerSvcServer struct {
+}
+
+func (UnimplementedEqualizerSvcServer) GetEqualizerPresets(context.Context, *Empty) (*GetEqualizerPresetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEqualizerPresets not implemented")
+}
+func (UnimplementedEqualizerSvcServer) AddEqualizerPreset(context.Context, *AddEqualizerPresetRequest) (*AddEqualizerPresetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEqualizerPreset not implemented")
+}
+func (UnimplementedEqualizerSvcServer) UpdateEqualizerPreset(context.Context, *UpdateEqualizerPresetRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEqualizerPreset not implemented")
+}
+func (UnimplementedEqualizerSvcServer) RemoveEqualizerPreset(context.Context, *RemoveEqualizerPresetRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEqualizerPreset not implemented")
+}
+func (UnimplementedEqualizerSvcServer) ActivateEqualizerPreset(context.Context, *ActivateEqualizerPresetRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateEqualizerPreset not implemented")
+}
+func (UnimplementedEqualizerSvcServer) mustEmbedUnimplementedEqualizerSvcServer() {}
+
+// UnsafeEqualizerSvcServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EqualizerSvcServer will
+// result in compilation errors.
+type UnsafeEqualizerSvcServer interface {
+	mustEmbedUnimplementedEqualizerSvcServer()
+}
+
+func RegisterEqualizerSvcServer(s grpc.ServiceRegistrar, srv EqualizerSvcServer) {
+	s.RegisterService(&EqualizerSvc_ServiceDesc, srv)
+}
+
+func _EqualizerSvc_GetEqualizerPresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EqualizerSvcServer).GetEqualizerPresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.EqualizerSvc/GetEqualizerPresets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EqualizerSvcServer).GetEqualizerPresets(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EqualizerSvc_AddEqualizerPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEqualizerPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EqualizerSvcServer).AddEqualizerPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.EqualizerSvc/AddEqualizerPreset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EqualizerSvcServer).AddEqualizerPreset(ctx, req.(*AddEqualizerPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EqualizerSvc_UpdateEqualizerPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEqualizerPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EqualizerSvcServer).UpdateEqualizerPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.EqualizerSvc/UpdateEqualizerPreset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EqualizerSvcServer).UpdateEqualizerPreset(ctx, req.(*UpdateEqualizerPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EqualizerSvc_RemoveEqualizerPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEqualizerPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EqualizerSvcServer).RemoveEqualizerPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.EqualizerSvc/RemoveEqualizerPreset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EqualizerSvcServer).RemoveEqualizerPreset(ctx, req.(*RemoveEqualizerPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EqualizerSvc_ActivateEqualizerPreset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActivateEqualizerPresetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EqualizerSvcServer).ActivateEqualizerPreset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.EqualizerSvc/ActivateEqualizerPreset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EqualizerSvcServer).ActivateEqualizerPreset(ctx, req.(*ActivateEqualizerPresetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EqualizerSvc_ServiceDesc is the grpc.ServiceDesc for EqualizerSvc service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EqualizerSvc_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "m3uetcpb.EqualizerSvc",
+	HandlerType: (*EqualizerSvcServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEqualizerPresets",
+			Handler:    _EqualizerSvc_GetEqualizerPresets_Handler,
+		},
+		{
+			MethodName: "AddEqualizerPreset",
+			Handler:    _EqualizerSvc_AddEqualizerPreset_Handler,
+		},
+		{
+			MethodName: "UpdateEqualizerPreset",
+			Handler:    _EqualizerSvc_UpdateEqualizerPreset_Handler,
+		},
+		{
+			MethodName: "RemoveEqualizerPreset",
+			Handler:    _EqualizerSvc_RemoveEqualizerPreset_Handler,
+		},
+		{
+			MethodName: "ActivateEqualizerPreset",
+			Handler:    _EqualizerSvc_ActivateEqualizerPreset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/m3uetcpb/equalizer.proto",
+}
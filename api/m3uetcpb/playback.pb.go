@@ -21,37 +21,147 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PlaybackEvent int32
+
+const (
+	PlaybackEvent_PE_NONE  PlaybackEvent = 0
+	PlaybackEvent_PE_ERROR PlaybackEvent = 1
+)
+
+// Enum value maps for PlaybackEvent.
+var (
+	PlaybackEvent_name = map[int32]string{
+		0: "PE_NONE",
+		1: "PE_ERROR",
+	}
+	PlaybackEvent_value = map[string]int32{
+		"PE_NONE":  0,
+		"PE_ERROR": 1,
+	}
+)
+
+func (x PlaybackEvent) Enum() *PlaybackEvent {
+	p := new(PlaybackEvent)
+	*p = x
+	return p
+}
+
+func (x PlaybackEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaybackEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_m3uetcpb_playback_proto_enumTypes[0].Descriptor()
+}
+
+func (PlaybackEvent) Type() protoreflect.EnumType {
+	return &file_api_m3uetcpb_playback_proto_enumTypes[0]
+}
+
+func (x PlaybackEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaybackEvent.Descriptor instead.
+func (PlaybackEvent) EnumDescriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{0}
+}
+
+type PlaybackFailureReason int32
+
+const (
+	PlaybackFailureReason_PF_UNKNOWN           PlaybackFailureReason = 0
+	PlaybackFailureReason_PF_MISSING_FILE      PlaybackFailureReason = 1
+	PlaybackFailureReason_PF_UNSUPPORTED_CODEC PlaybackFailureReason = 2
+	PlaybackFailureReason_PF_NETWORK           PlaybackFailureReason = 3
+)
+
+// Enum value maps for PlaybackFailureReason.
+var (
+	PlaybackFailureReason_name = map[int32]string{
+		0: "PF_UNKNOWN",
+		1: "PF_MISSING_FILE",
+		2: "PF_UNSUPPORTED_CODEC",
+		3: "PF_NETWORK",
+	}
+	PlaybackFailureReason_value = map[string]int32{
+		"PF_UNKNOWN":           0,
+		"PF_MISSING_FILE":      1,
+		"PF_UNSUPPORTED_CODEC": 2,
+		"PF_NETWORK":           3,
+	}
+)
+
+func (x PlaybackFailureReason) Enum() *PlaybackFailureReason {
+	p := new(PlaybackFailureReason)
+	*p = x
+	return p
+}
+
+func (x PlaybackFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlaybackFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_m3uetcpb_playback_proto_enumTypes[1].Descriptor()
+}
+
+func (PlaybackFailureReason) Type() protoreflect.EnumType {
+	return &file_api_m3uetcpb_playback_proto_enumTypes[1]
+}
+
+func (x PlaybackFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlaybackFailureReason.Descriptor instead.
+func (PlaybackFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{1}
+}
+
 type PlaybackAction int32
 
 const (
-	PlaybackAction_PB_NONE     PlaybackAction = 0
-	PlaybackAction_PB_PLAY     PlaybackAction = 1
-	PlaybackAction_PB_NEXT     PlaybackAction = 2
-	PlaybackAction_PB_PREVIOUS PlaybackAction = 3
-	PlaybackAction_PB_SEEK     PlaybackAction = 4
-	PlaybackAction_PB_PAUSE    PlaybackAction = 5
-	PlaybackAction_PB_STOP     PlaybackAction = 6
+	PlaybackAction_PB_NONE      PlaybackAction = 0
+	PlaybackAction_PB_PLAY      PlaybackAction = 1
+	PlaybackAction_PB_NEXT      PlaybackAction = 2
+	PlaybackAction_PB_PREVIOUS  PlaybackAction = 3
+	PlaybackAction_PB_SEEK      PlaybackAction = 4
+	PlaybackAction_PB_PAUSE     PlaybackAction = 5
+	PlaybackAction_PB_STOP      PlaybackAction = 6
+	PlaybackAction_PB_CROSSFADE PlaybackAction = 7
+	PlaybackAction_PB_RATE      PlaybackAction = 8
+	PlaybackAction_PB_REPEAT    PlaybackAction = 9
+	PlaybackAction_PB_SHUFFLE   PlaybackAction = 10
 )
 
 // Enum value maps for PlaybackAction.
 var (
 	PlaybackAction_name = map[int32]string{
-		0: "PB_NONE",
-		1: "PB_PLAY",
-		2: "PB_NEXT",
-		3: "PB_PREVIOUS",
-		4: "PB_SEEK",
-		5: "PB_PAUSE",
-		6: "PB_STOP",
+		0:  "PB_NONE",
+		1:  "PB_PLAY",
+		2:  "PB_NEXT",
+		3:  "PB_PREVIOUS",
+		4:  "PB_SEEK",
+		5:  "PB_PAUSE",
+		6:  "PB_STOP",
+		7:  "PB_CROSSFADE",
+		8:  "PB_RATE",
+		9:  "PB_REPEAT",
+		10: "PB_SHUFFLE",
 	}
 	PlaybackAction_value = map[string]int32{
-		"PB_NONE":     0,
-		"PB_PLAY":     1,
-		"PB_NEXT":     2,
-		"PB_PREVIOUS": 3,
-		"PB_SEEK":     4,
-		"PB_PAUSE":    5,
-		"PB_STOP":     6,
+		"PB_NONE":      0,
+		"PB_PLAY":      1,
+		"PB_NEXT":      2,
+		"PB_PREVIOUS":  3,
+		"PB_SEEK":      4,
+		"PB_PAUSE":     5,
+		"PB_STOP":      6,
+		"PB_CROSSFADE": 7,
+		"PB_RATE":      8,
+		"PB_REPEAT":    9,
+		"PB_SHUFFLE":   10,
 	}
 )
 
@@ -66,11 +176,11 @@ func (x PlaybackAction) String() string {
 }
 
 func (PlaybackAction) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_m3uetcpb_playback_proto_enumTypes[0].Descriptor()
+	return file_api_m3uetcpb_playback_proto_enumTypes[2].Descriptor()
 }
 
 func (PlaybackAction) Type() protoreflect.EnumType {
-	return &file_api_m3uetcpb_playback_proto_enumTypes[0]
+	return &file_api_m3uetcpb_playback_proto_enumTypes[2]
 }
 
 func (x PlaybackAction) Number() protoreflect.EnumNumber {
@@ -79,7 +189,160 @@ func (x PlaybackAction) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PlaybackAction.Descriptor instead.
 func (PlaybackAction) EnumDescriptor() ([]byte, []int) {
-	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{0}
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{2}
+}
+
+type RepeatMode int32
+
+const (
+	RepeatMode_REPEAT_NONE     RepeatMode = 0
+	RepeatMode_REPEAT_TRACK    RepeatMode = 1
+	RepeatMode_REPEAT_PLAYLIST RepeatMode = 2
+)
+
+// Enum value maps for RepeatMode.
+var (
+	RepeatMode_name = map[int32]string{
+		0: "REPEAT_NONE",
+		1: "REPEAT_TRACK",
+		2: "REPEAT_PLAYLIST",
+	}
+	RepeatMode_value = map[string]int32{
+		"REPEAT_NONE":     0,
+		"REPEAT_TRACK":    1,
+		"REPEAT_PLAYLIST": 2,
+	}
+)
+
+func (x RepeatMode) Enum() *RepeatMode {
+	p := new(RepeatMode)
+	*p = x
+	return p
+}
+
+func (x RepeatMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RepeatMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_m3uetcpb_playback_proto_enumTypes[3].Descriptor()
+}
+
+func (RepeatMode) Type() protoreflect.EnumType {
+	return &file_api_m3uetcpb_playback_proto_enumTypes[3]
+}
+
+func (x RepeatMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RepeatMode.Descriptor instead.
+func (RepeatMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{3}
+}
+
+type BroadcastFormat int32
+
+const (
+	BroadcastFormat_BROADCAST_DEFAULT BroadcastFormat = 0
+	BroadcastFormat_BROADCAST_OPUS    BroadcastFormat = 1
+	BroadcastFormat_BROADCAST_MP3     BroadcastFormat = 2
+)
+
+// Enum value maps for BroadcastFormat.
+var (
+	BroadcastFormat_name = map[int32]string{
+		0: "BROADCAST_DEFAULT",
+		1: "BROADCAST_OPUS",
+		2: "BROADCAST_MP3",
+	}
+	BroadcastFormat_value = map[string]int32{
+		"BROADCAST_DEFAULT": 0,
+		"BROADCAST_OPUS":    1,
+		"BROADCAST_MP3":     2,
+	}
+)
+
+func (x BroadcastFormat) Enum() *BroadcastFormat {
+	p := new(BroadcastFormat)
+	*p = x
+	return p
+}
+
+func (x BroadcastFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BroadcastFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_m3uetcpb_playback_proto_enumTypes[4].Descriptor()
+}
+
+func (BroadcastFormat) Type() protoreflect.EnumType {
+	return &file_api_m3uetcpb_playback_proto_enumTypes[4]
+}
+
+func (x BroadcastFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BroadcastFormat.Descriptor instead.
+func (BroadcastFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{4}
+}
+
+type SleepMode int32
+
+const (
+	SleepMode_SLEEP_OFF             SleepMode = 0
+	SleepMode_SLEEP_AFTER_MINUTES   SleepMode = 1
+	SleepMode_SLEEP_END_OF_TRACK    SleepMode = 2
+	SleepMode_SLEEP_END_OF_PLAYLIST SleepMode = 3
+	SleepMode_SLEEP_END_OF_QUEUE    SleepMode = 4
+)
+
+// Enum value maps for SleepMode.
+var (
+	SleepMode_name = map[int32]string{
+		0: "SLEEP_OFF",
+		1: "SLEEP_AFTER_MINUTES",
+		2: "SLEEP_END_OF_TRACK",
+		3: "SLEEP_END_OF_PLAYLIST",
+		4: "SLEEP_END_OF_QUEUE",
+	}
+	SleepMode_value = map[string]int32{
+		"SLEEP_OFF":             0,
+		"SLEEP_AFTER_MINUTES":   1,
+		"SLEEP_END_OF_TRACK":    2,
+		"SLEEP_END_OF_PLAYLIST": 3,
+		"SLEEP_END_OF_QUEUE":    4,
+	}
+)
+
+func (x SleepMode) Enum() *SleepMode {
+	p := new(SleepMode)
+	*p = x
+	return p
+}
+
+func (x SleepMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SleepMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_m3uetcpb_playback_proto_enumTypes[5].Descriptor()
+}
+
+func (SleepMode) Type() protoreflect.EnumType {
+	return &file_api_m3uetcpb_playback_proto_enumTypes[5]
+}
+
+func (x SleepMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SleepMode.Descriptor instead.
+func (SleepMode) EnumDescriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{5}
 }
 
 type GetPlaybackResponse struct {
@@ -87,13 +350,22 @@ type GetPlaybackResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsStreaming bool      `protobuf:"varint,1,opt,name=is_streaming,json=isStreaming,proto3" json:"is_streaming,omitempty"`
-	IsPlaying   bool      `protobuf:"varint,2,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
-	IsPaused    bool      `protobuf:"varint,3,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
-	IsStopped   bool      `protobuf:"varint,4,opt,name=is_stopped,json=isStopped,proto3" json:"is_stopped,omitempty"`
-	IsReady     bool      `protobuf:"varint,5,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
-	Playback    *Playback `protobuf:"bytes,6,opt,name=playback,proto3" json:"playback,omitempty"`
-	Track       *Track    `protobuf:"bytes,7,opt,name=track,proto3" json:"track,omitempty"`
+	IsStreaming bool              `protobuf:"varint,1,opt,name=is_streaming,json=isStreaming,proto3" json:"is_streaming,omitempty"`
+	IsPlaying   bool              `protobuf:"varint,2,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
+	IsPaused    bool              `protobuf:"varint,3,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	IsStopped   bool              `protobuf:"varint,4,opt,name=is_stopped,json=isStopped,proto3" json:"is_stopped,omitempty"`
+	IsReady     bool              `protobuf:"varint,5,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
+	Playback    *Playback         `protobuf:"bytes,6,opt,name=playback,proto3" json:"playback,omitempty"`
+	Track       *Track            `protobuf:"bytes,7,opt,name=track,proto3" json:"track,omitempty"`
+	Crossfade   bool              `protobuf:"varint,8,opt,name=crossfade,proto3" json:"crossfade,omitempty"`
+	Volume      float64           `protobuf:"fixed64,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	Mute        bool              `protobuf:"varint,10,opt,name=mute,proto3" json:"mute,omitempty"`
+	Rate        float64           `protobuf:"fixed64,11,opt,name=rate,proto3" json:"rate,omitempty"`
+	Repeat      RepeatMode        `protobuf:"varint,12,opt,name=repeat,proto3,enum=m3uetcpb.RepeatMode" json:"repeat,omitempty"`
+	Shuffle     bool              `protobuf:"varint,13,opt,name=shuffle,proto3" json:"shuffle,omitempty"`
+	SleepTimer  *SleepTimer       `protobuf:"bytes,14,opt,name=sleep_timer,json=sleepTimer,proto3" json:"sleep_timer,omitempty"`
+	StreamInfo  *StreamInfo       `protobuf:"bytes,15,opt,name=stream_info,json=streamInfo,proto3" json:"stream_info,omitempty"`
+	Progress    *PlaybackProgress `protobuf:"bytes,16,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
 func (x *GetPlaybackResponse) Reset() {
@@ -177,6 +449,69 @@ func (x *GetPlaybackResponse) GetTrack() *Track {
 	return nil
 }
 
+func (x *GetPlaybackResponse) GetCrossfade() bool {
+	if x != nil {
+		return x.Crossfade
+	}
+	return false
+}
+
+func (x *GetPlaybackResponse) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *GetPlaybackResponse) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+func (x *GetPlaybackResponse) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *GetPlaybackResponse) GetRepeat() RepeatMode {
+	if x != nil {
+		return x.Repeat
+	}
+	return RepeatMode_REPEAT_NONE
+}
+
+func (x *GetPlaybackResponse) GetShuffle() bool {
+	if x != nil {
+		return x.Shuffle
+	}
+	return false
+}
+
+func (x *GetPlaybackResponse) GetSleepTimer() *SleepTimer {
+	if x != nil {
+		return x.SleepTimer
+	}
+	return nil
+}
+
+func (x *GetPlaybackResponse) GetStreamInfo() *StreamInfo {
+	if x != nil {
+		return x.StreamInfo
+	}
+	return nil
+}
+
+func (x *GetPlaybackResponse) GetProgress() *PlaybackProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
 type GetPlaybackListResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -229,12 +564,17 @@ type ExecutePlaybackActionRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Action      PlaybackAction `protobuf:"varint,1,opt,name=action,proto3,enum=m3uetcpb.PlaybackAction" json:"action,omitempty"`
-	Force       bool           `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	Seek        int64          `protobuf:"varint,3,opt,name=seek,proto3" json:"seek,omitempty"`
-	Perspective Perspective    `protobuf:"varint,4,opt,name=perspective,proto3,enum=m3uetcpb.Perspective" json:"perspective,omitempty"`
-	Ids         []int64        `protobuf:"varint,5,rep,packed,name=ids,proto3" json:"ids,omitempty"`
-	Locations   []string       `protobuf:"bytes,6,rep,name=locations,proto3" json:"locations,omitempty"`
+	Action        PlaybackAction `protobuf:"varint,1,opt,name=action,proto3,enum=m3uetcpb.PlaybackAction" json:"action,omitempty"`
+	Force         bool           `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Seek          int64          `protobuf:"varint,3,opt,name=seek,proto3" json:"seek,omitempty"`
+	Perspective   Perspective    `protobuf:"varint,4,opt,name=perspective,proto3,enum=m3uetcpb.Perspective" json:"perspective,omitempty"`
+	Ids           []int64        `protobuf:"varint,5,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Locations     []string       `protobuf:"bytes,6,rep,name=locations,proto3" json:"locations,omitempty"`
+	Crossfade     bool           `protobuf:"varint,7,opt,name=crossfade,proto3" json:"crossfade,omitempty"`
+	Rate          float64        `protobuf:"fixed64,8,opt,name=rate,proto3" json:"rate,omitempty"`
+	Repeat        RepeatMode     `protobuf:"varint,9,opt,name=repeat,proto3,enum=m3uetcpb.RepeatMode" json:"repeat,omitempty"`
+	Shuffle       bool           `protobuf:"varint,10,opt,name=shuffle,proto3" json:"shuffle,omitempty"`
+	FromBeginning bool           `protobuf:"varint,11,opt,name=from_beginning,json=fromBeginning,proto3" json:"from_beginning,omitempty"`
 }
 
 func (x *ExecutePlaybackActionRequest) Reset() {
@@ -311,23 +651,52 @@ func (x *ExecutePlaybackActionRequest) GetLocations() []string {
 	return nil
 }
 
-type SubscribeToPlaybackResponse struct {
+func (x *ExecutePlaybackActionRequest) GetCrossfade() bool {
+	if x != nil {
+		return x.Crossfade
+	}
+	return false
+}
+
+func (x *ExecutePlaybackActionRequest) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *ExecutePlaybackActionRequest) GetRepeat() RepeatMode {
+	if x != nil {
+		return x.Repeat
+	}
+	return RepeatMode_REPEAT_NONE
+}
+
+func (x *ExecutePlaybackActionRequest) GetShuffle() bool {
+	if x != nil {
+		return x.Shuffle
+	}
+	return false
+}
+
+func (x *ExecutePlaybackActionRequest) GetFromBeginning() bool {
+	if x != nil {
+		return x.FromBeginning
+	}
+	return false
+}
+
+type GetVolumeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriptionId string    `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
-	IsStreaming    bool      `protobuf:"varint,2,opt,name=is_streaming,json=isStreaming,proto3" json:"is_streaming,omitempty"`
-	IsPlaying      bool      `protobuf:"varint,3,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
-	IsPaused       bool      `protobuf:"varint,4,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
-	IsStopped      bool      `protobuf:"varint,5,opt,name=is_stopped,json=isStopped,proto3" json:"is_stopped,omitempty"`
-	IsReady        bool      `protobuf:"varint,6,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
-	Playback       *Playback `protobuf:"bytes,7,opt,name=playback,proto3" json:"playback,omitempty"`
-	Track          *Track    `protobuf:"bytes,8,opt,name=track,proto3" json:"track,omitempty"`
+	Volume float64 `protobuf:"fixed64,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Mute   bool    `protobuf:"varint,2,opt,name=mute,proto3" json:"mute,omitempty"`
 }
 
-func (x *SubscribeToPlaybackResponse) Reset() {
-	*x = SubscribeToPlaybackResponse{}
+func (x *GetVolumeResponse) Reset() {
+	*x = GetVolumeResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_m3uetcpb_playback_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +704,13 @@ func (x *SubscribeToPlaybackResponse) Reset() {
 	}
 }
 
-func (x *SubscribeToPlaybackResponse) String() string {
+func (x *GetVolumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeToPlaybackResponse) ProtoMessage() {}
+func (*GetVolumeResponse) ProtoMessage() {}
 
-func (x *SubscribeToPlaybackResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVolumeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_m3uetcpb_playback_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,92 +722,181 @@ func (x *SubscribeToPlaybackResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeToPlaybackResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeToPlaybackResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVolumeResponse.ProtoReflect.Descriptor instead.
+func (*GetVolumeResponse) Descriptor() ([]byte, []int) {
 	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SubscribeToPlaybackResponse) GetSubscriptionId() string {
+func (x *GetVolumeResponse) GetVolume() float64 {
 	if x != nil {
-		return x.SubscriptionId
+		return x.Volume
 	}
-	return ""
+	return 0
 }
 
-func (x *SubscribeToPlaybackResponse) GetIsStreaming() bool {
+func (x *GetVolumeResponse) GetMute() bool {
 	if x != nil {
-		return x.IsStreaming
+		return x.Mute
 	}
 	return false
 }
 
-func (x *SubscribeToPlaybackResponse) GetIsPlaying() bool {
-	if x != nil {
-		return x.IsPlaying
-	}
-	return false
+type SetVolumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Volume *float64 `protobuf:"fixed64,1,opt,name=volume,proto3,oneof" json:"volume,omitempty"`
+	Mute   *bool    `protobuf:"varint,2,opt,name=mute,proto3,oneof" json:"mute,omitempty"`
 }
 
-func (x *SubscribeToPlaybackResponse) GetIsPaused() bool {
-	if x != nil {
-		return x.IsPaused
+func (x *SetVolumeRequest) Reset() {
+	*x = SetVolumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *SubscribeToPlaybackResponse) GetIsStopped() bool {
-	if x != nil {
-		return x.IsStopped
-	}
-	return false
+func (x *SetVolumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SubscribeToPlaybackResponse) GetIsReady() bool {
-	if x != nil {
-		return x.IsReady
+func (*SetVolumeRequest) ProtoMessage() {}
+
+func (x *SetVolumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *SubscribeToPlaybackResponse) GetPlayback() *Playback {
-	if x != nil {
-		return x.Playback
+// Deprecated: Use SetVolumeRequest.ProtoReflect.Descriptor instead.
+func (*SetVolumeRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetVolumeRequest) GetVolume() float64 {
+	if x != nil && x.Volume != nil {
+		return *x.Volume
 	}
-	return nil
+	return 0
 }
 
-func (x *SubscribeToPlaybackResponse) GetTrack() *Track {
+func (x *SetVolumeRequest) GetMute() bool {
+	if x != nil && x.Mute != nil {
+		return *x.Mute
+	}
+	return false
+}
+
+type ResumePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId   int64                  `protobuf:"varint,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Position  int64                  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	Duration  int64                  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ResumePosition) Reset() {
+	*x = ResumePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumePosition) ProtoMessage() {}
+
+func (x *ResumePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumePosition.ProtoReflect.Descriptor instead.
+func (*ResumePosition) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ResumePosition) GetTrackId() int64 {
 	if x != nil {
-		return x.Track
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *ResumePosition) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ResumePosition) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ResumePosition) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type UnsubscribeFromPlaybackRequest struct {
+// PlaybackProgress defines the progress of the current stream.
+type PlaybackProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Position  int64 `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`   // in nanoseconds
+	Duration  int64 `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`   // in nanoseconds, zero if unknown
+	Buffering int32 `protobuf:"varint,3,opt,name=buffering,proto3" json:"buffering,omitempty"` // percent, 100 when not buffering
+	Seekable  bool  `protobuf:"varint,4,opt,name=seekable,proto3" json:"seekable,omitempty"`
+	Live      bool  `protobuf:"varint,5,opt,name=live,proto3" json:"live,omitempty"`
 }
 
-func (x *UnsubscribeFromPlaybackRequest) Reset() {
-	*x = UnsubscribeFromPlaybackRequest{}
+func (x *PlaybackProgress) Reset() {
+	*x = PlaybackProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_m3uetcpb_playback_proto_msgTypes[4]
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UnsubscribeFromPlaybackRequest) String() string {
+func (x *PlaybackProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnsubscribeFromPlaybackRequest) ProtoMessage() {}
+func (*PlaybackProgress) ProtoMessage() {}
 
-func (x *UnsubscribeFromPlaybackRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_m3uetcpb_playback_proto_msgTypes[4]
+func (x *PlaybackProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,49 +907,149 @@ func (x *UnsubscribeFromPlaybackRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnsubscribeFromPlaybackRequest.ProtoReflect.Descriptor instead.
-func (*UnsubscribeFromPlaybackRequest) Descriptor() ([]byte, []int) {
-	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use PlaybackProgress.ProtoReflect.Descriptor instead.
+func (*PlaybackProgress) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *UnsubscribeFromPlaybackRequest) GetSubscriptionId() string {
+func (x *PlaybackProgress) GetPosition() int64 {
 	if x != nil {
-		return x.SubscriptionId
+		return x.Position
+	}
+	return 0
+}
+
+func (x *PlaybackProgress) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *PlaybackProgress) GetBuffering() int32 {
+	if x != nil {
+		return x.Buffering
+	}
+	return 0
+}
+
+func (x *PlaybackProgress) GetSeekable() bool {
+	if x != nil {
+		return x.Seekable
+	}
+	return false
+}
+
+func (x *PlaybackProgress) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+// StreamInfo defines the metadata reported by a remote stream (e.g., an
+// internet radio station) while it plays.
+type StreamInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title        string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Artist       string `protobuf:"bytes,2,opt,name=artist,proto3" json:"artist,omitempty"`
+	Organization string `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	Bitrate      int32  `protobuf:"varint,4,opt,name=bitrate,proto3" json:"bitrate,omitempty"` // in kbps
+}
+
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamInfo) ProtoMessage() {}
+
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-type Playback struct {
+func (x *StreamInfo) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetOrganization() string {
+	if x != nil {
+		return x.Organization
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetBitrate() int32 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+type StationHistory struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Location  string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Played    bool                   `protobuf:"varint,3,opt,name=played,proto3" json:"played,omitempty"`
-	Skip      int64                  `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
-	TrackId   int64                  `protobuf:"varint,5,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
-	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location     string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Title        string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Artist       string                 `protobuf:"bytes,4,opt,name=artist,proto3" json:"artist,omitempty"`
+	Organization string                 `protobuf:"bytes,5,opt,name=organization,proto3" json:"organization,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Playback) Reset() {
-	*x = Playback{}
+func (x *StationHistory) Reset() {
+	*x = StationHistory{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_m3uetcpb_playback_proto_msgTypes[5]
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Playback) String() string {
+func (x *StationHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Playback) ProtoMessage() {}
+func (*StationHistory) ProtoMessage() {}
 
-func (x *Playback) ProtoReflect() protoreflect.Message {
-	mi := &file_api_m3uetcpb_playback_proto_msgTypes[5]
+func (x *StationHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,248 +1060,2331 @@ func (x *Playback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Playback.ProtoReflect.Descriptor instead.
-func (*Playback) Descriptor() ([]byte, []int) {
-	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use StationHistory.ProtoReflect.Descriptor instead.
+func (*StationHistory) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Playback) GetId() int64 {
+func (x *StationHistory) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *Playback) GetLocation() string {
+func (x *StationHistory) GetLocation() string {
 	if x != nil {
 		return x.Location
 	}
 	return ""
 }
 
-func (x *Playback) GetPlayed() bool {
+func (x *StationHistory) GetTitle() string {
 	if x != nil {
-		return x.Played
+		return x.Title
 	}
-	return false
+	return ""
 }
 
-func (x *Playback) GetSkip() int64 {
+func (x *StationHistory) GetArtist() string {
 	if x != nil {
-		return x.Skip
+		return x.Artist
 	}
-	return 0
+	return ""
 }
 
-func (x *Playback) GetTrackId() int64 {
+func (x *StationHistory) GetOrganization() string {
 	if x != nil {
-		return x.TrackId
+		return x.Organization
 	}
-	return 0
+	return ""
 }
 
-func (x *Playback) GetCreatedAt() *timestamppb.Timestamp {
+func (x *StationHistory) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Playback) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
+type GetStationHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"` // empty for all stations
+	Limit    int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-var File_api_m3uetcpb_playback_proto protoreflect.FileDescriptor
+func (x *GetStationHistoryRequest) Reset() {
+	*x = GetStationHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var file_api_m3uetcpb_playback_proto_rawDesc = []byte{
-	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2f, 0x70,
-	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d,
-	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33,
-	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
-	0x2f, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
-	0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x02, 0x0a,
-	0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x6c,
-	0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50,
-	0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x75,
-	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x75,
-	0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x70,
-	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2e, 0x0a,
-	0x08, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62,
-	0x61, 0x63, 0x6b, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a,
-	0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
-	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x22, 0x58, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62,
-	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x3d, 0x0a, 0x10, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x33, 0x75, 0x65,
-	0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x0f, 0x70,
-	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe3,
-	0x01, 0x0a, 0x1c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61,
-	0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x30, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x18, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62,
-	0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x37, 0x0a, 0x0b, 0x70,
-	0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x15, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73,
-	0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x22, 0xb6, 0x02, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67,
-	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
-	0x69, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69,
-	0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69,
-	0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61,
-	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
-	0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x70, 0x6c,
-	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x22, 0x49, 0x0a,
-	0x1e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61,
-	0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69,
-	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x70,
-	0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x42, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42,
-	0x5f, 0x4e, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x50, 0x42, 0x5f, 0x50, 0x52,
-	0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x53,
-	0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x42, 0x5f, 0x50, 0x41, 0x55, 0x53,
-	0x45, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x10, 0x06,
-	0x32, 0x8c, 0x03, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x76, 0x63,
-	0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x1d, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x26, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
-	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12,
-	0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x1a, 0x25, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x17, 0x55, 0x6e, 0x73,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x79,
-	0x62, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
-	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x50,
-	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *GetStationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_api_m3uetcpb_playback_proto_rawDescOnce sync.Once
-	file_api_m3uetcpb_playback_proto_rawDescData = file_api_m3uetcpb_playback_proto_rawDesc
-)
+func (*GetStationHistoryRequest) ProtoMessage() {}
 
-func file_api_m3uetcpb_playback_proto_rawDescGZIP() []byte {
-	file_api_m3uetcpb_playback_proto_rawDescOnce.Do(func() {
-		file_api_m3uetcpb_playback_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_m3uetcpb_playback_proto_rawDescData)
-	})
-	return file_api_m3uetcpb_playback_proto_rawDescData
+func (x *GetStationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_api_m3uetcpb_playback_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_m3uetcpb_playback_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_api_m3uetcpb_playback_proto_goTypes = []interface{}{
-	(PlaybackAction)(0),                    // 0: m3uetcpb.PlaybackAction
-	(*GetPlaybackResponse)(nil),            // 1: m3uetcpb.GetPlaybackResponse
-	(*GetPlaybackListResponse)(nil),        // 2: m3uetcpb.GetPlaybackListResponse
-	(*ExecutePlaybackActionRequest)(nil),   // 3: m3uetcpb.ExecutePlaybackActionRequest
-	(*SubscribeToPlaybackResponse)(nil),    // 4: m3uetcpb.SubscribeToPlaybackResponse
-	(*UnsubscribeFromPlaybackRequest)(nil), // 5: m3uetcpb.UnsubscribeFromPlaybackRequest
-	(*Playback)(nil),                       // 6: m3uetcpb.Playback
-	(*Track)(nil),                          // 7: m3uetcpb.Track
-	(Perspective)(0),                       // 8: m3uetcpb.Perspective
-	(*timestamppb.Timestamp)(nil),          // 9: google.protobuf.Timestamp
-	(*Empty)(nil),                          // 10: m3uetcpb.Empty
+// Deprecated: Use GetStationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{9}
 }
-var file_api_m3uetcpb_playback_proto_depIdxs = []int32{
-	6,  // 0: m3uetcpb.GetPlaybackResponse.playback:type_name -> m3uetcpb.Playback
-	7,  // 1: m3uetcpb.GetPlaybackResponse.track:type_name -> m3uetcpb.Track
-	6,  // 2: m3uetcpb.GetPlaybackListResponse.playback_entries:type_name -> m3uetcpb.Playback
-	0,  // 3: m3uetcpb.ExecutePlaybackActionRequest.action:type_name -> m3uetcpb.PlaybackAction
-	8,  // 4: m3uetcpb.ExecutePlaybackActionRequest.perspective:type_name -> m3uetcpb.Perspective
-	6,  // 5: m3uetcpb.SubscribeToPlaybackResponse.playback:type_name -> m3uetcpb.Playback
-	7,  // 6: m3uetcpb.SubscribeToPlaybackResponse.track:type_name -> m3uetcpb.Track
-	9,  // 7: m3uetcpb.Playback.created_at:type_name -> google.protobuf.Timestamp
-	9,  // 8: m3uetcpb.Playback.updated_at:type_name -> google.protobuf.Timestamp
-	10, // 9: m3uetcpb.PlaybackSvc.GetPlayback:input_type -> m3uetcpb.Empty
-	10, // 10: m3uetcpb.PlaybackSvc.GetPlaybackList:input_type -> m3uetcpb.Empty
-	3,  // 11: m3uetcpb.PlaybackSvc.ExecutePlaybackAction:input_type -> m3uetcpb.ExecutePlaybackActionRequest
-	10, // 12: m3uetcpb.PlaybackSvc.SubscribeToPlayback:input_type -> m3uetcpb.Empty
-	5,  // 13: m3uetcpb.PlaybackSvc.UnsubscribeFromPlayback:input_type -> m3uetcpb.UnsubscribeFromPlaybackRequest
-	1,  // 14: m3uetcpb.PlaybackSvc.GetPlayback:output_type -> m3uetcpb.GetPlaybackResponse
-	2,  // 15: m3uetcpb.PlaybackSvc.GetPlaybackList:output_type -> m3uetcpb.GetPlaybackListResponse
-	10, // 16: m3uetcpb.PlaybackSvc.ExecutePlaybackAction:output_type -> m3uetcpb.Empty
-	4,  // 17: m3uetcpb.PlaybackSvc.SubscribeToPlayback:output_type -> m3uetcpb.SubscribeToPlaybackResponse
-	10, // 18: m3uetcpb.PlaybackSvc.UnsubscribeFromPlayback:output_type -> m3uetcpb.Empty
-	14, // [14:19] is the sub-list for method output_type
-	9,  // [9:14] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+
+func (x *GetStationHistoryRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
 }
 
-func init() { file_api_m3uetcpb_playback_proto_init() }
-func file_api_m3uetcpb_playback_proto_init() {
-	if File_api_m3uetcpb_playback_proto != nil {
-		return
+func (x *GetStationHistoryRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
 	}
-	file_api_m3uetcpb_empty_proto_init()
-	file_api_m3uetcpb_perspective_proto_init()
-	file_api_m3uetcpb_track_proto_init()
-	if !protoimpl.UnsafeEnabled {
+	return 0
+}
+
+type GetStationHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*StationHistory `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetStationHistoryResponse) Reset() {
+	*x = GetStationHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStationHistoryResponse) ProtoMessage() {}
+
+func (x *GetStationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetStationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetStationHistoryResponse) GetEntries() []*StationHistory {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// PlaybackFailure defines a location that failed to play and was skipped.
+type PlaybackFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location  string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	TrackId   int64                  `protobuf:"varint,3,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Reason    PlaybackFailureReason  `protobuf:"varint,4,opt,name=reason,proto3,enum=m3uetcpb.PlaybackFailureReason" json:"reason,omitempty"`
+	Message   string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Attempts  int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *PlaybackFailure) Reset() {
+	*x = PlaybackFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlaybackFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackFailure) ProtoMessage() {}
+
+func (x *PlaybackFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackFailure.ProtoReflect.Descriptor instead.
+func (*PlaybackFailure) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PlaybackFailure) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlaybackFailure) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *PlaybackFailure) GetTrackId() int64 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *PlaybackFailure) GetReason() PlaybackFailureReason {
+	if x != nil {
+		return x.Reason
+	}
+	return PlaybackFailureReason_PF_UNKNOWN
+}
+
+func (x *PlaybackFailure) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PlaybackFailure) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *PlaybackFailure) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PlaybackFailure) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetPlaybackFailuresResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failures []*PlaybackFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+}
+
+func (x *GetPlaybackFailuresResponse) Reset() {
+	*x = GetPlaybackFailuresResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPlaybackFailuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaybackFailuresResponse) ProtoMessage() {}
+
+func (x *GetPlaybackFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaybackFailuresResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaybackFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetPlaybackFailuresResponse) GetFailures() []*PlaybackFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+type RetryPlaybackFailuresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids   []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // empty for all
+	Force bool    `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+}
+
+func (x *RetryPlaybackFailuresRequest) Reset() {
+	*x = RetryPlaybackFailuresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPlaybackFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPlaybackFailuresRequest) ProtoMessage() {}
+
+func (x *RetryPlaybackFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPlaybackFailuresRequest.ProtoReflect.Descriptor instead.
+func (*RetryPlaybackFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RetryPlaybackFailuresRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *RetryPlaybackFailuresRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ClearPlaybackFailuresRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // empty for all
+}
+
+func (x *ClearPlaybackFailuresRequest) Reset() {
+	*x = ClearPlaybackFailuresRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClearPlaybackFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearPlaybackFailuresRequest) ProtoMessage() {}
+
+func (x *ClearPlaybackFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearPlaybackFailuresRequest.ProtoReflect.Descriptor instead.
+func (*ClearPlaybackFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ClearPlaybackFailuresRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Scrobble defines a play kept in the outbox of the scrobbling service.
+type Scrobble struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrackId     int64                  `protobuf:"varint,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Artist      string                 `protobuf:"bytes,3,opt,name=artist,proto3" json:"artist,omitempty"`
+	Title       string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Album       string                 `protobuf:"bytes,5,opt,name=album,proto3" json:"album,omitempty"`
+	Duration    int64                  `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	PlayedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=played_at,json=playedAt,proto3" json:"played_at,omitempty"`
+	SubmittedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"` // unset while pending
+	Attempts    int32                  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError   string                 `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *Scrobble) Reset() {
+	*x = Scrobble{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scrobble) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scrobble) ProtoMessage() {}
+
+func (x *Scrobble) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scrobble.ProtoReflect.Descriptor instead.
+func (*Scrobble) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Scrobble) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Scrobble) GetTrackId() int64 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *Scrobble) GetArtist() string {
+	if x != nil {
+		return x.Artist
+	}
+	return ""
+}
+
+func (x *Scrobble) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Scrobble) GetAlbum() string {
+	if x != nil {
+		return x.Album
+	}
+	return ""
+}
+
+func (x *Scrobble) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Scrobble) GetPlayedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PlayedAt
+	}
+	return nil
+}
+
+func (x *Scrobble) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *Scrobble) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Scrobble) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type GetScrobblesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending bool  `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"` // only those not submitted
+	Limit   int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`     // 0 for all
+}
+
+func (x *GetScrobblesRequest) Reset() {
+	*x = GetScrobblesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScrobblesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScrobblesRequest) ProtoMessage() {}
+
+func (x *GetScrobblesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScrobblesRequest.ProtoReflect.Descriptor instead.
+func (*GetScrobblesRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetScrobblesRequest) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+func (x *GetScrobblesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetScrobblesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scrobbles []*Scrobble `protobuf:"bytes,1,rep,name=scrobbles,proto3" json:"scrobbles,omitempty"`
+	Pending   int32       `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+}
+
+func (x *GetScrobblesResponse) Reset() {
+	*x = GetScrobblesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScrobblesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScrobblesResponse) ProtoMessage() {}
+
+func (x *GetScrobblesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScrobblesResponse.ProtoReflect.Descriptor instead.
+func (*GetScrobblesResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetScrobblesResponse) GetScrobbles() []*Scrobble {
+	if x != nil {
+		return x.Scrobbles
+	}
+	return nil
+}
+
+func (x *GetScrobblesResponse) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+type ExportScrobblesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location      string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`                                 // of the .scrobbler.log file
+	All           bool   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`                                          // include those already submitted
+	MarkSubmitted bool   `protobuf:"varint,3,opt,name=mark_submitted,json=markSubmitted,proto3" json:"mark_submitted,omitempty"` // mark the exported ones as submitted
+}
+
+func (x *ExportScrobblesRequest) Reset() {
+	*x = ExportScrobblesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportScrobblesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportScrobblesRequest) ProtoMessage() {}
+
+func (x *ExportScrobblesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportScrobblesRequest.ProtoReflect.Descriptor instead.
+func (*ExportScrobblesRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExportScrobblesRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ExportScrobblesRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *ExportScrobblesRequest) GetMarkSubmitted() bool {
+	if x != nil {
+		return x.MarkSubmitted
+	}
+	return false
+}
+
+type ExportScrobblesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ExportScrobblesResponse) Reset() {
+	*x = ExportScrobblesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportScrobblesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportScrobblesResponse) ProtoMessage() {}
+
+func (x *ExportScrobblesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportScrobblesResponse.ProtoReflect.Descriptor instead.
+func (*ExportScrobblesResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExportScrobblesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetResumePositionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackIds []int64 `protobuf:"varint,1,rep,packed,name=track_ids,json=trackIds,proto3" json:"track_ids,omitempty"`
+}
+
+func (x *GetResumePositionsRequest) Reset() {
+	*x = GetResumePositionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResumePositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResumePositionsRequest) ProtoMessage() {}
+
+func (x *GetResumePositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResumePositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetResumePositionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetResumePositionsRequest) GetTrackIds() []int64 {
+	if x != nil {
+		return x.TrackIds
+	}
+	return nil
+}
+
+type GetResumePositionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Positions []*ResumePosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+}
+
+func (x *GetResumePositionsResponse) Reset() {
+	*x = GetResumePositionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetResumePositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResumePositionsResponse) ProtoMessage() {}
+
+func (x *GetResumePositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetResumePositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetResumePositionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetResumePositionsResponse) GetPositions() []*ResumePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type SleepTimer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode      SleepMode `protobuf:"varint,1,opt,name=mode,proto3,enum=m3uetcpb.SleepMode" json:"mode,omitempty"`
+	Minutes   int32     `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	FadeOut   bool      `protobuf:"varint,3,opt,name=fade_out,json=fadeOut,proto3" json:"fade_out,omitempty"`
+	Pause     bool      `protobuf:"varint,4,opt,name=pause,proto3" json:"pause,omitempty"`
+	Remaining int64     `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"` // in nanoseconds, if known
+}
+
+func (x *SleepTimer) Reset() {
+	*x = SleepTimer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SleepTimer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SleepTimer) ProtoMessage() {}
+
+func (x *SleepTimer) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SleepTimer.ProtoReflect.Descriptor instead.
+func (*SleepTimer) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SleepTimer) GetMode() SleepMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SleepMode_SLEEP_OFF
+}
+
+func (x *SleepTimer) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *SleepTimer) GetFadeOut() bool {
+	if x != nil {
+		return x.FadeOut
+	}
+	return false
+}
+
+func (x *SleepTimer) GetPause() bool {
+	if x != nil {
+		return x.Pause
+	}
+	return false
+}
+
+func (x *SleepTimer) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+type SetSleepTimerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode    SleepMode `protobuf:"varint,1,opt,name=mode,proto3,enum=m3uetcpb.SleepMode" json:"mode,omitempty"`
+	Minutes int32     `protobuf:"varint,2,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	FadeOut bool      `protobuf:"varint,3,opt,name=fade_out,json=fadeOut,proto3" json:"fade_out,omitempty"`
+	Pause   bool      `protobuf:"varint,4,opt,name=pause,proto3" json:"pause,omitempty"`
+}
+
+func (x *SetSleepTimerRequest) Reset() {
+	*x = SetSleepTimerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetSleepTimerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSleepTimerRequest) ProtoMessage() {}
+
+func (x *SetSleepTimerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSleepTimerRequest.ProtoReflect.Descriptor instead.
+func (*SetSleepTimerRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetSleepTimerRequest) GetMode() SleepMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SleepMode_SLEEP_OFF
+}
+
+func (x *SetSleepTimerRequest) GetMinutes() int32 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+func (x *SetSleepTimerRequest) GetFadeOut() bool {
+	if x != nil {
+		return x.FadeOut
+	}
+	return false
+}
+
+func (x *SetSleepTimerRequest) GetPause() bool {
+	if x != nil {
+		return x.Pause
+	}
+	return false
+}
+
+type OutputDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sink   string `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`     // auto, fake, file or a GStreamer sink
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"` // sink's device or, for the file sink, the output path
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *OutputDevice) Reset() {
+	*x = OutputDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OutputDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputDevice) ProtoMessage() {}
+
+func (x *OutputDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputDevice.ProtoReflect.Descriptor instead.
+func (*OutputDevice) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OutputDevice) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+func (x *OutputDevice) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *OutputDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetOutputDevicesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Devices []*OutputDevice `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	Active  *OutputDevice   `protobuf:"bytes,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *GetOutputDevicesResponse) Reset() {
+	*x = GetOutputDevicesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOutputDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutputDevicesResponse) ProtoMessage() {}
+
+func (x *GetOutputDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutputDevicesResponse.ProtoReflect.Descriptor instead.
+func (*GetOutputDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOutputDevicesResponse) GetDevices() []*OutputDevice {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *GetOutputDevicesResponse) GetActive() *OutputDevice {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+type SetOutputDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sink   string `protobuf:"bytes,1,opt,name=sink,proto3" json:"sink,omitempty"`
+	Device string `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+}
+
+func (x *SetOutputDeviceRequest) Reset() {
+	*x = SetOutputDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOutputDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOutputDeviceRequest) ProtoMessage() {}
+
+func (x *SetOutputDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOutputDeviceRequest.ProtoReflect.Descriptor instead.
+func (*SetOutputDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetOutputDeviceRequest) GetSink() string {
+	if x != nil {
+		return x.Sink
+	}
+	return ""
+}
+
+func (x *SetOutputDeviceRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type Broadcast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active    bool            `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Format    BroadcastFormat `protobuf:"varint,2,opt,name=format,proto3,enum=m3uetcpb.BroadcastFormat" json:"format,omitempty"`
+	Bitrate   int32           `protobuf:"varint,3,opt,name=bitrate,proto3" json:"bitrate,omitempty"` // in kbps
+	Url       string          `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	Listeners int32           `protobuf:"varint,5,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	Title     string          `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *Broadcast) Reset() {
+	*x = Broadcast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Broadcast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Broadcast) ProtoMessage() {}
+
+func (x *Broadcast) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Broadcast.ProtoReflect.Descriptor instead.
+func (*Broadcast) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Broadcast) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Broadcast) GetFormat() BroadcastFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BroadcastFormat_BROADCAST_DEFAULT
+}
+
+func (x *Broadcast) GetBitrate() int32 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *Broadcast) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Broadcast) GetListeners() int32 {
+	if x != nil {
+		return x.Listeners
+	}
+	return 0
+}
+
+func (x *Broadcast) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type GetBroadcastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Broadcast *Broadcast `protobuf:"bytes,1,opt,name=broadcast,proto3" json:"broadcast,omitempty"`
+}
+
+func (x *GetBroadcastResponse) Reset() {
+	*x = GetBroadcastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBroadcastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBroadcastResponse) ProtoMessage() {}
+
+func (x *GetBroadcastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBroadcastResponse.ProtoReflect.Descriptor instead.
+func (*GetBroadcastResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBroadcastResponse) GetBroadcast() *Broadcast {
+	if x != nil {
+		return x.Broadcast
+	}
+	return nil
+}
+
+type StartBroadcastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format  BroadcastFormat `protobuf:"varint,1,opt,name=format,proto3,enum=m3uetcpb.BroadcastFormat" json:"format,omitempty"`
+	Bitrate int32           `protobuf:"varint,2,opt,name=bitrate,proto3" json:"bitrate,omitempty"` // in kbps, zero for the configured one
+}
+
+func (x *StartBroadcastRequest) Reset() {
+	*x = StartBroadcastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartBroadcastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartBroadcastRequest) ProtoMessage() {}
+
+func (x *StartBroadcastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartBroadcastRequest.ProtoReflect.Descriptor instead.
+func (*StartBroadcastRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StartBroadcastRequest) GetFormat() BroadcastFormat {
+	if x != nil {
+		return x.Format
+	}
+	return BroadcastFormat_BROADCAST_DEFAULT
+}
+
+func (x *StartBroadcastRequest) GetBitrate() int32 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+type SubscribeToPlaybackResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string            `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	IsStreaming    bool              `protobuf:"varint,2,opt,name=is_streaming,json=isStreaming,proto3" json:"is_streaming,omitempty"`
+	IsPlaying      bool              `protobuf:"varint,3,opt,name=is_playing,json=isPlaying,proto3" json:"is_playing,omitempty"`
+	IsPaused       bool              `protobuf:"varint,4,opt,name=is_paused,json=isPaused,proto3" json:"is_paused,omitempty"`
+	IsStopped      bool              `protobuf:"varint,5,opt,name=is_stopped,json=isStopped,proto3" json:"is_stopped,omitempty"`
+	IsReady        bool              `protobuf:"varint,6,opt,name=is_ready,json=isReady,proto3" json:"is_ready,omitempty"`
+	Playback       *Playback         `protobuf:"bytes,7,opt,name=playback,proto3" json:"playback,omitempty"`
+	Track          *Track            `protobuf:"bytes,8,opt,name=track,proto3" json:"track,omitempty"`
+	Crossfade      bool              `protobuf:"varint,9,opt,name=crossfade,proto3" json:"crossfade,omitempty"`
+	Volume         float64           `protobuf:"fixed64,10,opt,name=volume,proto3" json:"volume,omitempty"`
+	Mute           bool              `protobuf:"varint,11,opt,name=mute,proto3" json:"mute,omitempty"`
+	Rate           float64           `protobuf:"fixed64,12,opt,name=rate,proto3" json:"rate,omitempty"`
+	Repeat         RepeatMode        `protobuf:"varint,13,opt,name=repeat,proto3,enum=m3uetcpb.RepeatMode" json:"repeat,omitempty"`
+	Shuffle        bool              `protobuf:"varint,14,opt,name=shuffle,proto3" json:"shuffle,omitempty"`
+	SleepTimer     *SleepTimer       `protobuf:"bytes,15,opt,name=sleep_timer,json=sleepTimer,proto3" json:"sleep_timer,omitempty"`
+	StreamInfo     *StreamInfo       `protobuf:"bytes,16,opt,name=stream_info,json=streamInfo,proto3" json:"stream_info,omitempty"`
+	Progress       *PlaybackProgress `protobuf:"bytes,17,opt,name=progress,proto3" json:"progress,omitempty"`
+	Event          PlaybackEvent     `protobuf:"varint,18,opt,name=event,proto3,enum=m3uetcpb.PlaybackEvent" json:"event,omitempty"`
+	Failure        *PlaybackFailure  `protobuf:"bytes,19,opt,name=failure,proto3" json:"failure,omitempty"` // set for PE_ERROR
+}
+
+func (x *SubscribeToPlaybackResponse) Reset() {
+	*x = SubscribeToPlaybackResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeToPlaybackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeToPlaybackResponse) ProtoMessage() {}
+
+func (x *SubscribeToPlaybackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeToPlaybackResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeToPlaybackResponse) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubscribeToPlaybackResponse) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *SubscribeToPlaybackResponse) GetIsStreaming() bool {
+	if x != nil {
+		return x.IsStreaming
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetIsPlaying() bool {
+	if x != nil {
+		return x.IsPlaying
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetIsPaused() bool {
+	if x != nil {
+		return x.IsPaused
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetIsStopped() bool {
+	if x != nil {
+		return x.IsStopped
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetIsReady() bool {
+	if x != nil {
+		return x.IsReady
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetPlayback() *Playback {
+	if x != nil {
+		return x.Playback
+	}
+	return nil
+}
+
+func (x *SubscribeToPlaybackResponse) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *SubscribeToPlaybackResponse) GetCrossfade() bool {
+	if x != nil {
+		return x.Crossfade
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetVolume() float64 {
+	if x != nil {
+		return x.Volume
+	}
+	return 0
+}
+
+func (x *SubscribeToPlaybackResponse) GetMute() bool {
+	if x != nil {
+		return x.Mute
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SubscribeToPlaybackResponse) GetRepeat() RepeatMode {
+	if x != nil {
+		return x.Repeat
+	}
+	return RepeatMode_REPEAT_NONE
+}
+
+func (x *SubscribeToPlaybackResponse) GetShuffle() bool {
+	if x != nil {
+		return x.Shuffle
+	}
+	return false
+}
+
+func (x *SubscribeToPlaybackResponse) GetSleepTimer() *SleepTimer {
+	if x != nil {
+		return x.SleepTimer
+	}
+	return nil
+}
+
+func (x *SubscribeToPlaybackResponse) GetStreamInfo() *StreamInfo {
+	if x != nil {
+		return x.StreamInfo
+	}
+	return nil
+}
+
+func (x *SubscribeToPlaybackResponse) GetProgress() *PlaybackProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *SubscribeToPlaybackResponse) GetEvent() PlaybackEvent {
+	if x != nil {
+		return x.Event
+	}
+	return PlaybackEvent_PE_NONE
+}
+
+func (x *SubscribeToPlaybackResponse) GetFailure() *PlaybackFailure {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+type UnsubscribeFromPlaybackRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+}
+
+func (x *UnsubscribeFromPlaybackRequest) Reset() {
+	*x = UnsubscribeFromPlaybackRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeFromPlaybackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeFromPlaybackRequest) ProtoMessage() {}
+
+func (x *UnsubscribeFromPlaybackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeFromPlaybackRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeFromPlaybackRequest) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UnsubscribeFromPlaybackRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+type Playback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Location  string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Played    bool                   `protobuf:"varint,3,opt,name=played,proto3" json:"played,omitempty"`
+	Skip      int64                  `protobuf:"varint,4,opt,name=skip,proto3" json:"skip,omitempty"`
+	TrackId   int64                  `protobuf:"varint,5,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,101,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,102,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Playback) Reset() {
+	*x = Playback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_m3uetcpb_playback_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Playback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Playback) ProtoMessage() {}
+
+func (x *Playback) ProtoReflect() protoreflect.Message {
+	mi := &file_api_m3uetcpb_playback_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Playback.ProtoReflect.Descriptor instead.
+func (*Playback) Descriptor() ([]byte, []int) {
+	return file_api_m3uetcpb_playback_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Playback) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Playback) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Playback) GetPlayed() bool {
+	if x != nil {
+		return x.Played
+	}
+	return false
+}
+
+func (x *Playback) GetSkip() int64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *Playback) GetTrackId() int64 {
+	if x != nil {
+		return x.TrackId
+	}
+	return 0
+}
+
+func (x *Playback) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Playback) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_api_m3uetcpb_playback_proto protoreflect.FileDescriptor
+
+var file_api_m3uetcpb_playback_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2f, 0x70,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2f, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd1, 0x04, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x6c,
+	0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50,
+	0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2e, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a,
+	0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x66, 0x61, 0x64,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x66, 0x61,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75,
+	0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x6c,
+	0x65, 0x65, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x0a, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65,
+	0x72, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
+	0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x33, 0x75,
+	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x58, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x10, 0x70,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x84, 0x03, 0x0a, 0x1c, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x37, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69,
+	0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x66, 0x61, 0x64, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x66, 0x61, 0x64, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x65, 0x67, 0x69, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d, 0x75,
+	0x74, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07,
+	0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x75, 0x74, 0x65,
+	0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x98, 0x01, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x65, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x73, 0x65, 0x65, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x76, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x76, 0x65, 0x22, 0x78, 0x0a, 0x0a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62,
+	0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x4f, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x22, 0xbd, 0x02, 0x0a, 0x0f, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x54, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x46, 0x0a, 0x1c, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x22,
+	0x30, 0x0a, 0x1c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0xc8, 0x02, 0x0a, 0x08, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x62, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x73,
+	0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62,
+	0x6c, 0x65, 0x52, 0x09, 0x73, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x6d, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6d, 0x61, 0x72, 0x6b, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x38, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64,
+	0x73, 0x22, 0x54, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x36, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0a, 0x53, 0x6c, 0x65, 0x65,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x6c, 0x65, 0x65, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x61, 0x64,
+	0x65, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x64,
+	0x65, 0x4f, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x8a, 0x01, 0x0a, 0x14, 0x53, 0x65, 0x74,
+	0x53, 0x6c, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x27, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70,
+	0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x61, 0x64, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x64, 0x65, 0x4f, 0x75, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x7c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x30, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x4f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x4f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x22, 0x44, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x6e,
+	0x6b, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0xb6, 0x01, 0x0a, 0x09, 0x42, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64,
+	0x63, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x22, 0x49, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x09, 0x62, 0x72,
+	0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x09, 0x62, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x22, 0x64, 0x0a,
+	0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
+	0x62, 0x2e, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x22, 0xe6, 0x05, 0x0a, 0x1b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x73, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x12,
+	0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x6c, 0x61, 0x79, 0x69, 0x6e, 0x67, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x69,
+	0x73, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x53, 0x74, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x79, 0x12, 0x2e, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63,
+	0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x6f, 0x73, 0x73, 0x66, 0x61, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x66, 0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x6d, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75,
+	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x75, 0x66,
+	0x66, 0x6c, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x6c, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x0a, 0x73,
+	0x6c, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x1e,
+	0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x50,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x2a, 0x2a, 0x0a,
+	0x0d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x50,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x66, 0x0a, 0x15, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x46, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e,
+	0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x46, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47,
+	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x46, 0x5f, 0x55, 0x4e,
+	0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x43, 0x10,
+	0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x46, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10,
+	0x03, 0x2a, 0xae, 0x01, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x01, 0x12, 0x0b,
+	0x0a, 0x07, 0x50, 0x42, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x50,
+	0x42, 0x5f, 0x50, 0x52, 0x45, 0x56, 0x49, 0x4f, 0x55, 0x53, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x42, 0x5f, 0x53, 0x45, 0x45, 0x4b, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x42, 0x5f,
+	0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x05, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x53, 0x54,
+	0x4f, 0x50, 0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x42, 0x5f, 0x43, 0x52, 0x4f, 0x53, 0x53,
+	0x46, 0x41, 0x44, 0x45, 0x10, 0x07, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x42, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x42, 0x5f, 0x52, 0x45, 0x50, 0x45, 0x41, 0x54,
+	0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x42, 0x5f, 0x53, 0x48, 0x55, 0x46, 0x46, 0x4c, 0x45,
+	0x10, 0x0a, 0x2a, 0x44, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x45, 0x50, 0x45, 0x41, 0x54, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x45, 0x50, 0x45, 0x41, 0x54, 0x5f, 0x54, 0x52, 0x41, 0x43,
+	0x4b, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x45, 0x50, 0x45, 0x41, 0x54, 0x5f, 0x50, 0x4c,
+	0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02, 0x2a, 0x4f, 0x0a, 0x0f, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x42,
+	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54,
+	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f,
+	0x4f, 0x50, 0x55, 0x53, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
+	0x41, 0x53, 0x54, 0x5f, 0x4d, 0x50, 0x33, 0x10, 0x02, 0x2a, 0x7e, 0x0a, 0x09, 0x53, 0x6c, 0x65,
+	0x65, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x5f,
+	0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x5f, 0x41,
+	0x46, 0x54, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x53, 0x10, 0x01, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x46, 0x5f, 0x54,
+	0x52, 0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x5f,
+	0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x46, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x4c, 0x49, 0x53, 0x54, 0x10,
+	0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4c, 0x45, 0x45, 0x50, 0x5f, 0x45, 0x4e, 0x44, 0x5f, 0x4f,
+	0x46, 0x5f, 0x51, 0x55, 0x45, 0x55, 0x45, 0x10, 0x04, 0x32, 0x98, 0x0c, 0x0a, 0x0b, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x53, 0x76, 0x63, 0x12, 0x3d, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x50, 0x0a, 0x15, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61,
+	0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x62,
+	0x61, 0x63, 0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x0f,
+	0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x1b, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09,
+	0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x5f, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6d,
+	0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x15, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6c, 0x61,
+	0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x26, 0x2e,
+	0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6c,
+	0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x50, 0x0a, 0x15, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x50,
+	0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12,
+	0x26, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53,
+	0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75,
+	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x56, 0x0a, 0x0f,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x53, 0x63, 0x72, 0x6f, 0x62, 0x62, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x70,
+	0x54, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x53, 0x6c, 0x65, 0x65, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75,
+	0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x22, 0x2e, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61,
+	0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1e, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x0d, 0x53, 0x74,
+	0x6f, 0x70, 0x42, 0x72, 0x6f, 0x61, 0x64, 0x63, 0x61, 0x73, 0x74, 0x12, 0x0f, 0x2e, 0x6d, 0x33,
+	0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x6d,
+	0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x4f, 0x0a,
+	0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x25, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x79,
+	0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x54,
+	0x0a, 0x17, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x28, 0x2e, 0x6d, 0x33, 0x75, 0x65,
+	0x74, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x50, 0x6c, 0x61, 0x79, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x42, 0x0c, 0x5a, 0x0a, 0x2e, 0x2f, 0x6d, 0x33, 0x75, 0x65, 0x74, 0x63,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_m3uetcpb_playback_proto_rawDescOnce sync.Once
+	file_api_m3uetcpb_playback_proto_rawDescData = file_api_m3uetcpb_playback_proto_rawDesc
+)
+
+func file_api_m3uetcpb_playback_proto_rawDescGZIP() []byte {
+	file_api_m3uetcpb_playback_proto_rawDescOnce.Do(func() {
+		file_api_m3uetcpb_playback_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_m3uetcpb_playback_proto_rawDescData)
+	})
+	return file_api_m3uetcpb_playback_proto_rawDescData
+}
+
+var file_api_m3uetcpb_playback_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_m3uetcpb_playback_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_api_m3uetcpb_playback_proto_goTypes = []interface{}{
+	(PlaybackEvent)(0),                     // 0: m3uetcpb.PlaybackEvent
+	(PlaybackFailureReason)(0),             // 1: m3uetcpb.PlaybackFailureReason
+	(PlaybackAction)(0),                    // 2: m3uetcpb.PlaybackAction
+	(RepeatMode)(0),                        // 3: m3uetcpb.RepeatMode
+	(BroadcastFormat)(0),                   // 4: m3uetcpb.BroadcastFormat
+	(SleepMode)(0),                         // 5: m3uetcpb.SleepMode
+	(*GetPlaybackResponse)(nil),            // 6: m3uetcpb.GetPlaybackResponse
+	(*GetPlaybackListResponse)(nil),        // 7: m3uetcpb.GetPlaybackListResponse
+	(*ExecutePlaybackActionRequest)(nil),   // 8: m3uetcpb.ExecutePlaybackActionRequest
+	(*GetVolumeResponse)(nil),              // 9: m3uetcpb.GetVolumeResponse
+	(*SetVolumeRequest)(nil),               // 10: m3uetcpb.SetVolumeRequest
+	(*ResumePosition)(nil),                 // 11: m3uetcpb.ResumePosition
+	(*PlaybackProgress)(nil),               // 12: m3uetcpb.PlaybackProgress
+	(*StreamInfo)(nil),                     // 13: m3uetcpb.StreamInfo
+	(*StationHistory)(nil),                 // 14: m3uetcpb.StationHistory
+	(*GetStationHistoryRequest)(nil),       // 15: m3uetcpb.GetStationHistoryRequest
+	(*GetStationHistoryResponse)(nil),      // 16: m3uetcpb.GetStationHistoryResponse
+	(*PlaybackFailure)(nil),                // 17: m3uetcpb.PlaybackFailure
+	(*GetPlaybackFailuresResponse)(nil),    // 18: m3uetcpb.GetPlaybackFailuresResponse
+	(*RetryPlaybackFailuresRequest)(nil),   // 19: m3uetcpb.RetryPlaybackFailuresRequest
+	(*ClearPlaybackFailuresRequest)(nil),   // 20: m3uetcpb.ClearPlaybackFailuresRequest
+	(*Scrobble)(nil),                       // 21: m3uetcpb.Scrobble
+	(*GetScrobblesRequest)(nil),            // 22: m3uetcpb.GetScrobblesRequest
+	(*GetScrobblesResponse)(nil),           // 23: m3uetcpb.GetScrobblesResponse
+	(*ExportScrobblesRequest)(nil),         // 24: m3uetcpb.ExportScrobblesRequest
+	(*ExportScrobblesResponse)(nil),        // 25: m3uetcpb.ExportScrobblesResponse
+	(*GetResumePositionsRequest)(nil),      // 26: m3uetcpb.GetResumePositionsRequest
+	(*GetResumePositionsResponse)(nil),     // 27: m3uetcpb.GetResumePositionsResponse
+	(*SleepTimer)(nil),                     // 28: m3uetcpb.SleepTimer
+	(*SetSleepTimerRequest)(nil),           // 29: m3uetcpb.SetSleepTimerRequest
+	(*OutputDevice)(nil),                   // 30: m3uetcpb.OutputDevice
+	(*GetOutputDevicesResponse)(nil),       // 31: m3uetcpb.GetOutputDevicesResponse
+	(*SetOutputDeviceRequest)(nil),         // 32: m3uetcpb.SetOutputDeviceRequest
+	(*Broadcast)(nil),                      // 33: m3uetcpb.Broadcast
+	(*GetBroadcastResponse)(nil),           // 34: m3uetcpb.GetBroadcastResponse
+	(*StartBroadcastRequest)(nil),          // 35: m3uetcpb.StartBroadcastRequest
+	(*SubscribeToPlaybackResponse)(nil),    // 36: m3uetcpb.SubscribeToPlaybackResponse
+	(*UnsubscribeFromPlaybackRequest)(nil), // 37: m3uetcpb.UnsubscribeFromPlaybackRequest
+	(*Playback)(nil),                       // 38: m3uetcpb.Playback
+	(*Track)(nil),                          // 39: m3uetcpb.Track
+	(Perspective)(0),                       // 40: m3uetcpb.Perspective
+	(*timestamppb.Timestamp)(nil),          // 41: google.protobuf.Timestamp
+	(*Empty)(nil),                          // 42: m3uetcpb.Empty
+}
+var file_api_m3uetcpb_playback_proto_depIdxs = []int32{
+	38, // 0: m3uetcpb.GetPlaybackResponse.playback:type_name -> m3uetcpb.Playback
+	39, // 1: m3uetcpb.GetPlaybackResponse.track:type_name -> m3uetcpb.Track
+	3,  // 2: m3uetcpb.GetPlaybackResponse.repeat:type_name -> m3uetcpb.RepeatMode
+	28, // 3: m3uetcpb.GetPlaybackResponse.sleep_timer:type_name -> m3uetcpb.SleepTimer
+	13, // 4: m3uetcpb.GetPlaybackResponse.stream_info:type_name -> m3uetcpb.StreamInfo
+	12, // 5: m3uetcpb.GetPlaybackResponse.progress:type_name -> m3uetcpb.PlaybackProgress
+	38, // 6: m3uetcpb.GetPlaybackListResponse.playback_entries:type_name -> m3uetcpb.Playback
+	2,  // 7: m3uetcpb.ExecutePlaybackActionRequest.action:type_name -> m3uetcpb.PlaybackAction
+	40, // 8: m3uetcpb.ExecutePlaybackActionRequest.perspective:type_name -> m3uetcpb.Perspective
+	3,  // 9: m3uetcpb.ExecutePlaybackActionRequest.repeat:type_name -> m3uetcpb.RepeatMode
+	41, // 10: m3uetcpb.ResumePosition.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 11: m3uetcpb.StationHistory.created_at:type_name -> google.protobuf.Timestamp
+	14, // 12: m3uetcpb.GetStationHistoryResponse.entries:type_name -> m3uetcpb.StationHistory
+	1,  // 13: m3uetcpb.PlaybackFailure.reason:type_name -> m3uetcpb.PlaybackFailureReason
+	41, // 14: m3uetcpb.PlaybackFailure.created_at:type_name -> google.protobuf.Timestamp
+	41, // 15: m3uetcpb.PlaybackFailure.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 16: m3uetcpb.GetPlaybackFailuresResponse.failures:type_name -> m3uetcpb.PlaybackFailure
+	41, // 17: m3uetcpb.Scrobble.played_at:type_name -> google.protobuf.Timestamp
+	41, // 18: m3uetcpb.Scrobble.submitted_at:type_name -> google.protobuf.Timestamp
+	21, // 19: m3uetcpb.GetScrobblesResponse.scrobbles:type_name -> m3uetcpb.Scrobble
+	11, // 20: m3uetcpb.GetResumePositionsResponse.positions:type_name -> m3uetcpb.ResumePosition
+	5,  // 21: m3uetcpb.SleepTimer.mode:type_name -> m3uetcpb.SleepMode
+	5,  // 22: m3uetcpb.SetSleepTimerRequest.mode:type_name -> m3uetcpb.SleepMode
+	30, // 23: m3uetcpb.GetOutputDevicesResponse.devices:type_name -> m3uetcpb.OutputDevice
+	30, // 24: m3uetcpb.GetOutputDevicesResponse.active:type_name -> m3uetcpb.OutputDevice
+	4,  // 25: m3uetcpb.Broadcast.format:type_name -> m3uetcpb.BroadcastFormat
+	33, // 26: m3uetcpb.GetBroadcastResponse.broadcast:type_name -> m3uetcpb.Broadcast
+	4,  // 27: m3uetcpb.StartBroadcastRequest.format:type_name -> m3uetcpb.BroadcastFormat
+	38, // 28: m3uetcpb.SubscribeToPlaybackResponse.playback:type_name -> m3uetcpb.Playback
+	39, // 29: m3uetcpb.SubscribeToPlaybackResponse.track:type_name -> m3uetcpb.Track
+	3,  // 30: m3uetcpb.SubscribeToPlaybackResponse.repeat:type_name -> m3uetcpb.RepeatMode
+	28, // 31: m3uetcpb.SubscribeToPlaybackResponse.sleep_timer:type_name -> m3uetcpb.SleepTimer
+	13, // 32: m3uetcpb.SubscribeToPlaybackResponse.stream_info:type_name -> m3uetcpb.StreamInfo
+	12, // 33: m3uetcpb.SubscribeToPlaybackResponse.progress:type_name -> m3uetcpb.PlaybackProgress
+	0,  // 34: m3uetcpb.SubscribeToPlaybackResponse.event:type_name -> m3uetcpb.PlaybackEvent
+	17, // 35: m3uetcpb.SubscribeToPlaybackResponse.failure:type_name -> m3uetcpb.PlaybackFailure
+	41, // 36: m3uetcpb.Playback.created_at:type_name -> google.protobuf.Timestamp
+	41, // 37: m3uetcpb.Playback.updated_at:type_name -> google.protobuf.Timestamp
+	42, // 38: m3uetcpb.PlaybackSvc.GetPlayback:input_type -> m3uetcpb.Empty
+	42, // 39: m3uetcpb.PlaybackSvc.GetPlaybackList:input_type -> m3uetcpb.Empty
+	8,  // 40: m3uetcpb.PlaybackSvc.ExecutePlaybackAction:input_type -> m3uetcpb.ExecutePlaybackActionRequest
+	42, // 41: m3uetcpb.PlaybackSvc.GetVolume:input_type -> m3uetcpb.Empty
+	10, // 42: m3uetcpb.PlaybackSvc.SetVolume:input_type -> m3uetcpb.SetVolumeRequest
+	26, // 43: m3uetcpb.PlaybackSvc.GetResumePositions:input_type -> m3uetcpb.GetResumePositionsRequest
+	15, // 44: m3uetcpb.PlaybackSvc.GetStationHistory:input_type -> m3uetcpb.GetStationHistoryRequest
+	42, // 45: m3uetcpb.PlaybackSvc.GetPlaybackFailures:input_type -> m3uetcpb.Empty
+	19, // 46: m3uetcpb.PlaybackSvc.RetryPlaybackFailures:input_type -> m3uetcpb.RetryPlaybackFailuresRequest
+	20, // 47: m3uetcpb.PlaybackSvc.ClearPlaybackFailures:input_type -> m3uetcpb.ClearPlaybackFailuresRequest
+	22, // 48: m3uetcpb.PlaybackSvc.GetScrobbles:input_type -> m3uetcpb.GetScrobblesRequest
+	42, // 49: m3uetcpb.PlaybackSvc.SubmitScrobbles:input_type -> m3uetcpb.Empty
+	24, // 50: m3uetcpb.PlaybackSvc.ExportScrobbles:input_type -> m3uetcpb.ExportScrobblesRequest
+	29, // 51: m3uetcpb.PlaybackSvc.SetSleepTimer:input_type -> m3uetcpb.SetSleepTimerRequest
+	42, // 52: m3uetcpb.PlaybackSvc.GetOutputDevices:input_type -> m3uetcpb.Empty
+	32, // 53: m3uetcpb.PlaybackSvc.SetOutputDevice:input_type -> m3uetcpb.SetOutputDeviceRequest
+	42, // 54: m3uetcpb.PlaybackSvc.GetBroadcast:input_type -> m3uetcpb.Empty
+	35, // 55: m3uetcpb.PlaybackSvc.StartBroadcast:input_type -> m3uetcpb.StartBroadcastRequest
+	42, // 56: m3uetcpb.PlaybackSvc.StopBroadcast:input_type -> m3uetcpb.Empty
+	42, // 57: m3uetcpb.PlaybackSvc.SubscribeToPlayback:input_type -> m3uetcpb.Empty
+	37, // 58: m3uetcpb.PlaybackSvc.UnsubscribeFromPlayback:input_type -> m3uetcpb.UnsubscribeFromPlaybackRequest
+	6,  // 59: m3uetcpb.PlaybackSvc.GetPlayback:output_type -> m3uetcpb.GetPlaybackResponse
+	7,  // 60: m3uetcpb.PlaybackSvc.GetPlaybackList:output_type -> m3uetcpb.GetPlaybackListResponse
+	42, // 61: m3uetcpb.PlaybackSvc.ExecutePlaybackAction:output_type -> m3uetcpb.Empty
+	9,  // 62: m3uetcpb.PlaybackSvc.GetVolume:output_type -> m3uetcpb.GetVolumeResponse
+	42, // 63: m3uetcpb.PlaybackSvc.SetVolume:output_type -> m3uetcpb.Empty
+	27, // 64: m3uetcpb.PlaybackSvc.GetResumePositions:output_type -> m3uetcpb.GetResumePositionsResponse
+	16, // 65: m3uetcpb.PlaybackSvc.GetStationHistory:output_type -> m3uetcpb.GetStationHistoryResponse
+	18, // 66: m3uetcpb.PlaybackSvc.GetPlaybackFailures:output_type -> m3uetcpb.GetPlaybackFailuresResponse
+	42, // 67: m3uetcpb.PlaybackSvc.RetryPlaybackFailures:output_type -> m3uetcpb.Empty
+	42, // 68: m3uetcpb.PlaybackSvc.ClearPlaybackFailures:output_type -> m3uetcpb.Empty
+	23, // 69: m3uetcpb.PlaybackSvc.GetScrobbles:output_type -> m3uetcpb.GetScrobblesResponse
+	42, // 70: m3uetcpb.PlaybackSvc.SubmitScrobbles:output_type -> m3uetcpb.Empty
+	25, // 71: m3uetcpb.PlaybackSvc.ExportScrobbles:output_type -> m3uetcpb.ExportScrobblesResponse
+	42, // 72: m3uetcpb.PlaybackSvc.SetSleepTimer:output_type -> m3uetcpb.Empty
+	31, // 73: m3uetcpb.PlaybackSvc.GetOutputDevices:output_type -> m3uetcpb.GetOutputDevicesResponse
+	42, // 74: m3uetcpb.PlaybackSvc.SetOutputDevice:output_type -> m3uetcpb.Empty
+	34, // 75: m3uetcpb.PlaybackSvc.GetBroadcast:output_type -> m3uetcpb.GetBroadcastResponse
+	42, // 76: m3uetcpb.PlaybackSvc.StartBroadcast:output_type -> m3uetcpb.Empty
+	42, // 77: m3uetcpb.PlaybackSvc.StopBroadcast:output_type -> m3uetcpb.Empty
+	36, // 78: m3uetcpb.PlaybackSvc.SubscribeToPlayback:output_type -> m3uetcpb.SubscribeToPlaybackResponse
+	42, // 79: m3uetcpb.PlaybackSvc.UnsubscribeFromPlayback:output_type -> m3uetcpb.Empty
+	59, // [59:80] is the sub-list for method output_type
+	38, // [38:59] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_api_m3uetcpb_playback_proto_init() }
+func file_api_m3uetcpb_playback_proto_init() {
+	if File_api_m3uetcpb_playback_proto != nil {
+		return
+	}
+	file_api_m3uetcpb_empty_proto_init()
+	file_api_m3uetcpb_perspective_proto_init()
+	file_api_m3uetcpb_track_proto_init()
+	if !protoimpl.UnsafeEnabled {
 		file_api_m3uetcpb_playback_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetPlaybackResponse); i {
 			case 0:
@@ -756,8 +3397,44 @@ func file_api_m3uetcpb_playback_proto_init() {
 				return nil
 			}
 		}
-		file_api_m3uetcpb_playback_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPlaybackListResponse); i {
+		file_api_m3uetcpb_playback_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaybackListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutePlaybackActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVolumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetVolumeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -768,8 +3445,8 @@ func file_api_m3uetcpb_playback_proto_init() {
 				return nil
 			}
 		}
-		file_api_m3uetcpb_playback_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecutePlaybackActionRequest); i {
+		file_api_m3uetcpb_playback_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumePosition); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -780,7 +3457,295 @@ func file_api_m3uetcpb_playback_proto_init() {
 				return nil
 			}
 		}
-		file_api_m3uetcpb_playback_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_api_m3uetcpb_playback_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybackProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StationHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStationHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlaybackFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPlaybackFailuresResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPlaybackFailuresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearPlaybackFailuresRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scrobble); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScrobblesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScrobblesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportScrobblesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportScrobblesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResumePositionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetResumePositionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SleepTimer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSleepTimerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OutputDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOutputDevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOutputDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Broadcast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBroadcastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartBroadcastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_m3uetcpb_playback_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeToPlaybackResponse); i {
 			case 0:
 				return &v.state
@@ -792,7 +3757,7 @@ func file_api_m3uetcpb_playback_proto_init() {
 				return nil
 			}
 		}
-		file_api_m3uetcpb_playback_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_api_m3uetcpb_playback_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UnsubscribeFromPlaybackRequest); i {
 			case 0:
 				return &v.state
@@ -804,7 +3769,7 @@ func file_api_m3uetcpb_playback_proto_init() {
 				return nil
 			}
 		}
-		file_api_m3uetcpb_playback_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_api_m3uetcpb_playback_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Playback); i {
 			case 0:
 				return &v.state
@@ -817,13 +3782,14 @@ func file_api_m3uetcpb_playback_proto_init() {
 			}
 		}
 	}
+	file_api_m3uetcpb_playback_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_m3uetcpb_playback_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   6,
+			NumEnums:      6,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,22 @@ type PlaybackSvcClient interface {
 	GetPlayback(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPlaybackResponse, error)
 	GetPlaybackList(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPlaybackListResponse, error)
 	ExecutePlaybackAction(ctx context.Context, in *ExecutePlaybackActionRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetVolume(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetVolumeResponse, error)
+	SetVolume(ctx context.Context, in *SetVolumeRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetResumePositions(ctx context.Context, in *GetResumePositionsRequest, opts ...grpc.CallOption) (*GetResumePositionsResponse, error)
+	GetStationHistory(ctx context.Context, in *GetStationHistoryRequest, opts ...grpc.CallOption) (*GetStationHistoryResponse, error)
+	GetPlaybackFailures(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPlaybackFailuresResponse, error)
+	RetryPlaybackFailures(ctx context.Context, in *RetryPlaybackFailuresRequest, opts ...grpc.CallOption) (*Empty, error)
+	ClearPlaybackFailures(ctx context.Context, in *ClearPlaybackFailuresRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetScrobbles(ctx context.Context, in *GetScrobblesRequest, opts ...grpc.CallOption) (*GetScrobblesResponse, error)
+	SubmitScrobbles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ExportScrobbles(ctx context.Context, in *ExportScrobblesRequest, opts ...grpc.CallOption) (*ExportScrobblesResponse, error)
+	SetSleepTimer(ctx context.Context, in *SetSleepTimerRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetOutputDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetOutputDevicesResponse, error)
+	SetOutputDevice(ctx context.Context, in *SetOutputDeviceRequest, opts ...grpc.CallOption) (*Empty, error)
+	GetBroadcast(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetBroadcastResponse, error)
+	StartBroadcast(ctx context.Context, in *StartBroadcastRequest, opts ...grpc.CallOption) (*Empty, error)
+	StopBroadcast(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
 	SubscribeToPlayback(ctx context.Context, in *Empty, opts ...grpc.CallOption) (PlaybackSvc_SubscribeToPlaybackClient, error)
 	UnsubscribeFromPlayback(ctx context.Context, in *UnsubscribeFromPlaybackRequest, opts ...grpc.CallOption) (*Empty, error)
 }
@@ -64,6 +80,150 @@ func (c *playbackSvcClient) ExecutePlaybackAction(ctx context.Context, in *Execu
 	return out, nil
 }
 
+func (c *playbackSvcClient) GetVolume(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetVolumeResponse, error) {
+	out := new(GetVolumeResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) SetVolume(ctx context.Context, in *SetVolumeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/SetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) GetResumePositions(ctx context.Context, in *GetResumePositionsRequest, opts ...grpc.CallOption) (*GetResumePositionsResponse, error) {
+	out := new(GetResumePositionsResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetResumePositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) GetStationHistory(ctx context.Context, in *GetStationHistoryRequest, opts ...grpc.CallOption) (*GetStationHistoryResponse, error) {
+	out := new(GetStationHistoryResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetStationHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) GetPlaybackFailures(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetPlaybackFailuresResponse, error) {
+	out := new(GetPlaybackFailuresResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetPlaybackFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) RetryPlaybackFailures(ctx context.Context, in *RetryPlaybackFailuresRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/RetryPlaybackFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) ClearPlaybackFailures(ctx context.Context, in *ClearPlaybackFailuresRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/ClearPlaybackFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) GetScrobbles(ctx context.Context, in *GetScrobblesRequest, opts ...grpc.CallOption) (*GetScrobblesResponse, error) {
+	out := new(GetScrobblesResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetScrobbles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) SubmitScrobbles(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/SubmitScrobbles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) ExportScrobbles(ctx context.Context, in *ExportScrobblesRequest, opts ...grpc.CallOption) (*ExportScrobblesResponse, error) {
+	out := new(ExportScrobblesResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/ExportScrobbles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) SetSleepTimer(ctx context.Context, in *SetSleepTimerRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/SetSleepTimer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) GetOutputDevices(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetOutputDevicesResponse, error) {
+	out := new(GetOutputDevicesResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetOutputDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) SetOutputDevice(ctx context.Context, in *SetOutputDeviceRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/SetOutputDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) GetBroadcast(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*GetBroadcastResponse, error) {
+	out := new(GetBroadcastResponse)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/GetBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) StartBroadcast(ctx context.Context, in *StartBroadcastRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/StartBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playbackSvcClient) StopBroadcast(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/m3uetcpb.PlaybackSvc/StopBroadcast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *playbackSvcClient) SubscribeToPlayback(ctx context.Context, in *Empty, opts ...grpc.CallOption) (PlaybackSvc_SubscribeToPlaybackClient, error) {
 	stream, err := c.cc.NewStream(ctx, &PlaybackSvc_ServiceDesc.Streams[0], "/m3uetcpb.PlaybackSvc/SubscribeToPlayback", opts...)
 	if err != nil {
@@ -112,6 +272,22 @@ type PlaybackSvcServer interface {
 	GetPlayback(context.Context, *Empty) (*GetPlaybackResponse, error)
 	GetPlaybackList(context.Context, *Empty) (*GetPlaybackListResponse, error)
 	ExecutePlaybackAction(context.Context, *ExecutePlaybackActionRequest) (*Empty, error)
+	GetVolume(context.Context, *Empty) (*GetVolumeResponse, error)
+	SetVolume(context.Context, *SetVolumeRequest) (*Empty, error)
+	GetResumePositions(context.Context, *GetResumePositionsRequest) (*GetResumePositionsResponse, error)
+	GetStationHistory(context.Context, *GetStationHistoryRequest) (*GetStationHistoryResponse, error)
+	GetPlaybackFailures(context.Context, *Empty) (*GetPlaybackFailuresResponse, error)
+	RetryPlaybackFailures(context.Context, *RetryPlaybackFailuresRequest) (*Empty, error)
+	ClearPlaybackFailures(context.Context, *ClearPlaybackFailuresRequest) (*Empty, error)
+	GetScrobbles(context.Context, *GetScrobblesRequest) (*GetScrobblesResponse, error)
+	SubmitScrobbles(context.Context, *Empty) (*Empty, error)
+	ExportScrobbles(context.Context, *ExportScrobblesRequest) (*ExportScrobblesResponse, error)
+	SetSleepTimer(context.Context, *SetSleepTimerRequest) (*Empty, error)
+	GetOutputDevices(context.Context, *Empty) (*GetOutputDevicesResponse, error)
+	SetOutputDevice(context.Context, *SetOutputDeviceRequest) (*Empty, error)
+	GetBroadcast(context.Context, *Empty) (*GetBroadcastResponse, error)
+	StartBroadcast(context.Context, *StartBroadcastRequest) (*Empty, error)
+	StopBroadcast(context.Context, *Empty) (*Empty, error)
 	SubscribeToPlayback(*Empty, PlaybackSvc_SubscribeToPlaybackServer) error
 	UnsubscribeFromPlayback(context.Context, *UnsubscribeFromPlaybackRequest) (*Empty, error)
 	mustEmbedUnimplementedPlaybackSvcServer()
@@ -130,6 +306,54 @@ func (UnimplementedPlaybackSvcServer) GetPlaybackList(context.Context, *Empty) (
 func (UnimplementedPlaybackSvcServer) ExecutePlaybackAction(context.Context, *ExecutePlaybackActionRequest) (*Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecutePlaybackAction not implemented")
 }
+func (UnimplementedPlaybackSvcServer) GetVolume(context.Context, *Empty) (*GetVolumeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolume not implemented")
+}
+func (UnimplementedPlaybackSvcServer) SetVolume(context.Context, *SetVolumeRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVolume not implemented")
+}
+func (UnimplementedPlaybackSvcServer) GetResumePositions(context.Context, *GetResumePositionsRequest) (*GetResumePositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResumePositions not implemented")
+}
+func (UnimplementedPlaybackSvcServer) GetStationHistory(context.Context, *GetStationHistoryRequest) (*GetStationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStationHistory not implemented")
+}
+func (UnimplementedPlaybackSvcServer) GetPlaybackFailures(context.Context, *Empty) (*GetPlaybackFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPlaybackFailures not implemented")
+}
+func (UnimplementedPlaybackSvcServer) RetryPlaybackFailures(context.Context, *RetryPlaybackFailuresRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryPlaybackFailures not implemented")
+}
+func (UnimplementedPlaybackSvcServer) ClearPlaybackFailures(context.Context, *ClearPlaybackFailuresRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPlaybackFailures not implemented")
+}
+func (UnimplementedPlaybackSvcServer) GetScrobbles(context.Context, *GetScrobblesRequest) (*GetScrobblesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScrobbles not implemented")
+}
+func (UnimplementedPlaybackSvcServer) SubmitScrobbles(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitScrobbles not implemented")
+}
+func (UnimplementedPlaybackSvcServer) ExportScrobbles(context.Context, *ExportScrobblesRequest) (*ExportScrobblesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportScrobbles not implemented")
+}
+func (UnimplementedPlaybackSvcServer) SetSleepTimer(context.Context, *SetSleepTimerRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSleepTimer not implemented")
+}
+func (UnimplementedPlaybackSvcServer) GetOutputDevices(context.Context, *Empty) (*GetOutputDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOutputDevices not implemented")
+}
+func (UnimplementedPlaybackSvcServer) SetOutputDevice(context.Context, *SetOutputDeviceRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOutputDevice not implemented")
+}
+func (UnimplementedPlaybackSvcServer) GetBroadcast(context.Context, *Empty) (*GetBroadcastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBroadcast not implemented")
+}
+func (UnimplementedPlaybackSvcServer) StartBroadcast(context.Context, *StartBroadcastRequest) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartBroadcast not implemented")
+}
+func (UnimplementedPlaybackSvcServer) StopBroadcast(context.Context, *Empty) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopBroadcast not implemented")
+}
 func (UnimplementedPlaybackSvcServer) SubscribeToPlayback(*Empty, PlaybackSvc_SubscribeToPlaybackServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeToPlayback not implemented")
 }
@@ -203,6 +427,294 @@ func _PlaybackSvc_ExecutePlaybackAction_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlaybackSvc_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetVolume(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_SetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).SetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/SetVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).SetVolume(ctx, req.(*SetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_GetResumePositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResumePositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetResumePositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetResumePositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetResumePositions(ctx, req.(*GetResumePositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_GetStationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetStationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetStationHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetStationHistory(ctx, req.(*GetStationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_GetPlaybackFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetPlaybackFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetPlaybackFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetPlaybackFailures(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_RetryPlaybackFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryPlaybackFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).RetryPlaybackFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/RetryPlaybackFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).RetryPlaybackFailures(ctx, req.(*RetryPlaybackFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_ClearPlaybackFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearPlaybackFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).ClearPlaybackFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/ClearPlaybackFailures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).ClearPlaybackFailures(ctx, req.(*ClearPlaybackFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_GetScrobbles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScrobblesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetScrobbles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetScrobbles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetScrobbles(ctx, req.(*GetScrobblesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_SubmitScrobbles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).SubmitScrobbles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/SubmitScrobbles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).SubmitScrobbles(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_ExportScrobbles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportScrobblesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).ExportScrobbles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/ExportScrobbles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).ExportScrobbles(ctx, req.(*ExportScrobblesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_SetSleepTimer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSleepTimerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).SetSleepTimer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/SetSleepTimer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).SetSleepTimer(ctx, req.(*SetSleepTimerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_GetOutputDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetOutputDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetOutputDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetOutputDevices(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_SetOutputDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOutputDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).SetOutputDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/SetOutputDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).SetOutputDevice(ctx, req.(*SetOutputDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_GetBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).GetBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/GetBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).GetBroadcast(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_StartBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartBroadcastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).StartBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/StartBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).StartBroadcast(ctx, req.(*StartBroadcastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlaybackSvc_StopBroadcast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlaybackSvcServer).StopBroadcast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/m3uetcpb.PlaybackSvc/StopBroadcast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlaybackSvcServer).StopBroadcast(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PlaybackSvc_SubscribeToPlayback_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(Empty)
 	if err := stream.RecvMsg(m); err != nil {
@@ -261,6 +773,70 @@ var PlaybackSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecutePlaybackAction",
 			Handler:    _PlaybackSvc_ExecutePlaybackAction_Handler,
 		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _PlaybackSvc_GetVolume_Handler,
+		},
+		{
+			MethodName: "SetVolume",
+			Handler:    _PlaybackSvc_SetVolume_Handler,
+		},
+		{
+			MethodName: "GetResumePositions",
+			Handler:    _PlaybackSvc_GetResumePositions_Handler,
+		},
+		{
+			MethodName: "GetStationHistory",
+			Handler:    _PlaybackSvc_GetStationHistory_Handler,
+		},
+		{
+			MethodName: "GetPlaybackFailures",
+			Handler:    _PlaybackSvc_GetPlaybackFailures_Handler,
+		},
+		{
+			MethodName: "RetryPlaybackFailures",
+			Handler:    _PlaybackSvc_RetryPlaybackFailures_Handler,
+		},
+		{
+			MethodName: "ClearPlaybackFailures",
+			Handler:    _PlaybackSvc_ClearPlaybackFailures_Handler,
+		},
+		{
+			MethodName: "GetScrobbles",
+			Handler:    _PlaybackSvc_GetScrobbles_Handler,
+		},
+		{
+			MethodName: "SubmitScrobbles",
+			Handler:    _PlaybackSvc_SubmitScrobbles_Handler,
+		},
+		{
+			MethodName: "ExportScrobbles",
+			Handler:    _PlaybackSvc_ExportScrobbles_Handler,
+		},
+		{
+			MethodName: "SetSleepTimer",
+			Handler:    _PlaybackSvc_SetSleepTimer_Handler,
+		},
+		{
+			MethodName: "GetOutputDevices",
+			Handler:    _PlaybackSvc_GetOutputDevices_Handler,
+		},
+		{
+			MethodName: "SetOutputDevice",
+			Handler:    _PlaybackSvc_SetOutputDevice_Handler,
+		},
+		{
+			MethodName: "GetBroadcast",
+			Handler:    _PlaybackSvc_GetBroadcast_Handler,
+		},
+		{
+			MethodName: "StartBroadcast",
+			Handler:    _PlaybackSvc_StartBroadcast_Handler,
+		},
+		{
+			MethodName: "StopBroadcast",
+			Handler:    _PlaybackSvc_StopBroadcast_Handler,
+		},
 		{
 			MethodName: "UnsubscribeFromPlayback",
 			Handler:    _PlaybackSvc_UnsubscribeFromPlayback_Handler,
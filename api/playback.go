@@ -7,8 +7,10 @@ import (
 
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
 	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
 	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
 	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+	"github.com/jwmwalrus/m3u-etcetera/internal/scrobble"
 	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,7 +31,15 @@ func (svc *PlaybackSvc) GetPlayback(_ context.Context,
 		IsPaused:    svc.PbEvents.IsPaused(),
 		IsStopped:   svc.PbEvents.IsStopped(),
 		IsReady:     svc.PbEvents.IsReady(),
+		Crossfade:   svc.PbEvents.IsCrossfadeEnabled(),
+		Rate:        svc.PbEvents.GetRate(),
+		Repeat:      m3uetcpb.RepeatMode(svc.PbEvents.GetRepeatMode()),
+		Shuffle:     svc.PbEvents.GetShuffle(),
+		SleepTimer:  svc.sleepTimerToProtobuf(),
+		StreamInfo:  svc.streamInfoToProtobuf(),
+		Progress:    svc.progressToProtobuf(),
 	}
+	res.Volume, res.Mute = svc.PbEvents.GetVolume()
 	pb, t := svc.PbEvents.GetPlayback()
 	if pb != nil {
 		res.Playback = pb.ToProtobuf().(*m3uetcpb.Playback)
@@ -78,6 +88,21 @@ func (svc *PlaybackSvc) ExecutePlaybackAction(_ context.Context,
 		}
 	}
 
+	if req.Action == m3uetcpb.PlaybackAction_PB_REPEAT {
+		if _, ok := m3uetcpb.RepeatMode_name[int32(req.Repeat)]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Invalid repeat mode: %v", req.Repeat)
+		}
+	}
+
+	if req.Action == m3uetcpb.PlaybackAction_PB_RATE {
+		if req.Rate < playback.MinimumRate || req.Rate > playback.MaximumRate {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Rate must be between %v and %v: %v",
+				playback.MinimumRate, playback.MaximumRate, req.Rate)
+		}
+	}
+
 	go func() {
 		if !slices.Contains(
 			[]m3uetcpb.PlaybackAction{
@@ -100,10 +125,16 @@ func (svc *PlaybackSvc) ExecutePlaybackAction(_ context.Context,
 		case m3uetcpb.PlaybackAction_PB_SEEK:
 			svc.PbEvents.SeekInStream(req.Seek)
 		case m3uetcpb.PlaybackAction_PB_NEXT:
+			if req.FromBeginning {
+				svc.PbEvents.SetFromBeginning()
+			}
 			svc.PbEvents.NextStream()
 		case m3uetcpb.PlaybackAction_PB_PAUSE:
 			svc.PbEvents.PauseStream(false)
 		case m3uetcpb.PlaybackAction_PB_PLAY:
+			if req.FromBeginning && (req.Force || !svc.PbEvents.IsStreaming()) {
+				svc.PbEvents.SetFromBeginning()
+			}
 			if len(req.Locations) > 0 || len(req.Ids) > 0 {
 				if req.Force {
 					svc.PbEvents.PlayStreams(req.Force, req.Locations, req.Ids)
@@ -118,9 +149,32 @@ func (svc *PlaybackSvc) ExecutePlaybackAction(_ context.Context,
 				svc.PbEvents.PauseStream(true)
 			}
 		case m3uetcpb.PlaybackAction_PB_PREVIOUS:
+			if req.FromBeginning {
+				svc.PbEvents.SetFromBeginning()
+			}
 			svc.PbEvents.PreviousStream()
 		case m3uetcpb.PlaybackAction_PB_STOP:
 			svc.PbEvents.StopAll()
+		case m3uetcpb.PlaybackAction_PB_CROSSFADE:
+			svc.PbEvents.SetCrossfade(req.Crossfade)
+		case m3uetcpb.PlaybackAction_PB_RATE:
+			svc.PbEvents.SetRate(req.Rate)
+		case m3uetcpb.PlaybackAction_PB_REPEAT:
+			err := svc.PbEvents.SetRepeatMode(
+				models.PerspectiveIndex(req.Perspective),
+				models.RepeatMode(req.Repeat),
+			)
+			if err != nil {
+				slog.Error("Failed to set repeat mode", "error", err)
+			}
+		case m3uetcpb.PlaybackAction_PB_SHUFFLE:
+			err := svc.PbEvents.SetShuffle(
+				models.PerspectiveIndex(req.Perspective),
+				req.Shuffle,
+			)
+			if err != nil {
+				slog.Error("Failed to set shuffle", "error", err)
+			}
 		default:
 			return
 		}
@@ -129,6 +183,290 @@ func (svc *PlaybackSvc) ExecutePlaybackAction(_ context.Context,
 	return &m3uetcpb.Empty{}, nil
 }
 
+func (svc *PlaybackSvc) GetVolume(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.GetVolumeResponse, error) {
+
+	res := &m3uetcpb.GetVolumeResponse{}
+	res.Volume, res.Mute = svc.PbEvents.GetVolume()
+	return res, nil
+}
+
+func (svc *PlaybackSvc) SetVolume(_ context.Context,
+	req *m3uetcpb.SetVolumeRequest) (*m3uetcpb.Empty, error) {
+
+	if req.Volume == nil && req.Mute == nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Either volume or mute is required")
+	}
+
+	if req.Volume != nil {
+		if req.GetVolume() < 0 || req.GetVolume() > 1 {
+			return nil, status.Errorf(codes.InvalidArgument,
+				"Volume must be between 0 and 1: %v", req.GetVolume())
+		}
+		svc.PbEvents.SetVolume(req.GetVolume())
+	}
+
+	if req.Mute != nil {
+		svc.PbEvents.SetMute(req.GetMute())
+	}
+
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (*PlaybackSvc) GetResumePositions(_ context.Context,
+	req *m3uetcpb.GetResumePositionsRequest) (*m3uetcpb.GetResumePositionsResponse, error) {
+
+	res := &m3uetcpb.GetResumePositionsResponse{}
+	for _, rp := range models.GetResumePositions(req.TrackIds) {
+		res.Positions = append(
+			res.Positions,
+			rp.ToProtobuf().(*m3uetcpb.ResumePosition),
+		)
+	}
+	return res, nil
+}
+
+func (*PlaybackSvc) GetStationHistory(_ context.Context,
+	req *m3uetcpb.GetStationHistoryRequest) (*m3uetcpb.GetStationHistoryResponse, error) {
+
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Limit cannot be negative")
+	}
+
+	res := &m3uetcpb.GetStationHistoryResponse{}
+	for _, sh := range models.GetStationHistory(req.Location, int(req.Limit)) {
+		res.Entries = append(
+			res.Entries,
+			sh.ToProtobuf().(*m3uetcpb.StationHistory),
+		)
+	}
+	return res, nil
+}
+
+func (*PlaybackSvc) GetPlaybackFailures(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.GetPlaybackFailuresResponse, error) {
+
+	res := &m3uetcpb.GetPlaybackFailuresResponse{}
+	for _, pf := range models.GetPlaybackFailures(nil) {
+		res.Failures = append(
+			res.Failures,
+			pf.ToProtobuf().(*m3uetcpb.PlaybackFailure),
+		)
+	}
+	return res, nil
+}
+
+func (svc *PlaybackSvc) RetryPlaybackFailures(_ context.Context,
+	req *m3uetcpb.RetryPlaybackFailuresRequest) (*m3uetcpb.Empty, error) {
+
+	pfs := models.GetPlaybackFailures(req.Ids)
+	if len(req.Ids) > 0 && len(pfs) != len(req.Ids) {
+		return nil, status.Errorf(codes.NotFound,
+			"Some of the given playback failures do not exist")
+	}
+	if len(pfs) == 0 {
+		return &m3uetcpb.Empty{}, nil
+	}
+
+	ids := []int64{}
+	locations := []string{}
+	for _, pf := range pfs {
+		ids = append(ids, pf.ID)
+		locations = append(locations, pf.Location)
+	}
+
+	if err := models.DeletePlaybackFailures(ids); err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"Error clearing playback failures: %v", err)
+	}
+
+	svc.PbEvents.PlayStreams(req.Force, locations, nil)
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (*PlaybackSvc) ClearPlaybackFailures(_ context.Context,
+	req *m3uetcpb.ClearPlaybackFailuresRequest) (*m3uetcpb.Empty, error) {
+
+	if err := models.DeletePlaybackFailures(req.Ids); err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"Error clearing playback failures: %v", err)
+	}
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (*PlaybackSvc) GetScrobbles(_ context.Context,
+	req *m3uetcpb.GetScrobblesRequest) (*m3uetcpb.GetScrobblesResponse, error) {
+
+	if req.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"The limit cannot be negative: %v", req.Limit)
+	}
+
+	res := &m3uetcpb.GetScrobblesResponse{
+		Pending: int32(len(models.GetScrobbles(true, 0))),
+	}
+	for _, s := range models.GetScrobbles(req.Pending, int(req.Limit)) {
+		res.Scrobbles = append(
+			res.Scrobbles,
+			s.ToProtobuf().(*m3uetcpb.Scrobble),
+		)
+	}
+	return res, nil
+}
+
+func (*PlaybackSvc) SubmitScrobbles(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.Empty, error) {
+
+	if err := scrobble.Submit(); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Unable to submit scrobbles: %v", err)
+	}
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (*PlaybackSvc) ExportScrobbles(_ context.Context,
+	req *m3uetcpb.ExportScrobblesRequest) (*m3uetcpb.ExportScrobblesResponse, error) {
+
+	if req.Location == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"The target location for the scrobbler log is required")
+	}
+
+	n, err := scrobble.ExportLog(req.Location, req.All, req.MarkSubmitted)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"Error exporting scrobbles: %v", err)
+	}
+	return &m3uetcpb.ExportScrobblesResponse{Count: int32(n)}, nil
+}
+
+func (svc *PlaybackSvc) SetSleepTimer(_ context.Context,
+	req *m3uetcpb.SetSleepTimerRequest) (*m3uetcpb.Empty, error) {
+
+	if _, ok := m3uetcpb.SleepMode_name[int32(req.Mode)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid sleep mode: %v", req.Mode)
+	}
+
+	if req.Mode == m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES && req.Minutes <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"A positive number of minutes is required: %v", req.Minutes)
+	}
+
+	err := svc.PbEvents.SetSleepTimer(playback.SleepTimer{
+		Mode:    playback.SleepMode(req.Mode),
+		Minutes: int(req.Minutes),
+		FadeOut: req.FadeOut,
+		Pause:   req.Pause,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Error setting sleep timer: %v", err)
+	}
+
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (svc *PlaybackSvc) GetOutputDevices(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.GetOutputDevicesResponse, error) {
+
+	active := svc.PbEvents.GetOutputDevice()
+	res := &m3uetcpb.GetOutputDevicesResponse{
+		Active: &m3uetcpb.OutputDevice{
+			Sink:   active.Sink,
+			Device: active.Device,
+		},
+	}
+
+	for _, od := range svc.PbEvents.GetOutputDevices() {
+		if od.Sink == active.Sink && od.Device == active.Device {
+			res.Active.Name = od.Name
+		}
+		res.Devices = append(res.Devices, &m3uetcpb.OutputDevice{
+			Sink:   od.Sink,
+			Device: od.Device,
+			Name:   od.Name,
+		})
+	}
+
+	return res, nil
+}
+
+func (svc *PlaybackSvc) SetOutputDevice(_ context.Context,
+	req *m3uetcpb.SetOutputDeviceRequest) (*m3uetcpb.Empty, error) {
+
+	if req.Sink == "" {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"An output sink is required")
+	}
+
+	err := svc.PbEvents.SetOutputDevice(playback.OutputDevice{
+		Sink:   req.Sink,
+		Device: req.Device,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Error setting output device: %v", err)
+	}
+
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (svc *PlaybackSvc) GetBroadcast(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.GetBroadcastResponse, error) {
+
+	bs := svc.PbEvents.GetBroadcast()
+	return &m3uetcpb.GetBroadcastResponse{
+		Broadcast: &m3uetcpb.Broadcast{
+			Active:    bs.Active,
+			Format:    broadcastFormatToProtobuf(bs.Format),
+			Bitrate:   int32(bs.Bitrate),
+			Url:       bs.URL,
+			Listeners: int32(bs.Listeners),
+			Title:     bs.Title,
+		},
+	}, nil
+}
+
+func (svc *PlaybackSvc) StartBroadcast(_ context.Context,
+	req *m3uetcpb.StartBroadcastRequest) (*m3uetcpb.Empty, error) {
+
+	if _, ok := m3uetcpb.BroadcastFormat_name[int32(req.Format)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Invalid broadcast format: %v", req.Format)
+	}
+
+	if req.Bitrate < 0 {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Bitrate cannot be negative: %v", req.Bitrate)
+	}
+
+	format := ""
+	switch req.Format {
+	case m3uetcpb.BroadcastFormat_BROADCAST_OPUS:
+		format = config.BroadcastOpus
+	case m3uetcpb.BroadcastFormat_BROADCAST_MP3:
+		format = config.BroadcastMP3
+	}
+
+	if err := svc.PbEvents.StartBroadcast(format, int(req.Bitrate)); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Error starting broadcast: %v", err)
+	}
+
+	return &m3uetcpb.Empty{}, nil
+}
+
+func (svc *PlaybackSvc) StopBroadcast(_ context.Context,
+	_ *m3uetcpb.Empty) (*m3uetcpb.Empty, error) {
+
+	svc.PbEvents.StopBroadcast()
+
+	return &m3uetcpb.Empty{}, nil
+}
+
 func (svc *PlaybackSvc) SubscribeToPlayback(_ *m3uetcpb.Empty,
 	stream m3uetcpb.PlaybackSvc_SubscribeToPlaybackServer) error {
 
@@ -154,7 +492,19 @@ sLoop:
 				IsPaused:       svc.PbEvents.IsPaused(),
 				IsStopped:      svc.PbEvents.IsStopped(),
 				IsReady:        svc.PbEvents.IsReady(),
+				Crossfade:      svc.PbEvents.IsCrossfadeEnabled(),
+				Rate:           svc.PbEvents.GetRate(),
+				Repeat:         m3uetcpb.RepeatMode(svc.PbEvents.GetRepeatMode()),
+				Shuffle:        svc.PbEvents.GetShuffle(),
+				SleepTimer:     svc.sleepTimerToProtobuf(),
+				StreamInfo:     svc.streamInfoToProtobuf(),
+				Progress:       svc.progressToProtobuf(),
+				Event:          m3uetcpb.PlaybackEvent(e.Idx),
 			}
+			if pf, ok := e.Data.(*models.PlaybackFailure); ok {
+				res.Failure = pf.ToProtobuf().(*m3uetcpb.PlaybackFailure)
+			}
+			res.Volume, res.Mute = svc.PbEvents.GetVolume()
 
 			pb, t := svc.PbEvents.GetPlayback()
 			if pb != nil {
@@ -195,3 +545,49 @@ func (*PlaybackSvc) UnsubscribeFromPlayback(_ context.Context,
 
 	return &m3uetcpb.Empty{}, nil
 }
+
+func (svc *PlaybackSvc) sleepTimerToProtobuf() *m3uetcpb.SleepTimer {
+	st, remaining := svc.PbEvents.GetSleepTimer()
+	return &m3uetcpb.SleepTimer{
+		Mode:      m3uetcpb.SleepMode(st.Mode),
+		Minutes:   int32(st.Minutes),
+		FadeOut:   st.FadeOut,
+		Pause:     st.Pause,
+		Remaining: int64(remaining),
+	}
+}
+
+func (svc *PlaybackSvc) progressToProtobuf() *m3uetcpb.PlaybackProgress {
+	p := svc.PbEvents.GetProgress()
+	return &m3uetcpb.PlaybackProgress{
+		Position:  p.Position,
+		Duration:  p.Duration,
+		Buffering: int32(p.Buffering),
+		Seekable:  p.Seekable,
+		Live:      p.Live,
+	}
+}
+
+func (svc *PlaybackSvc) streamInfoToProtobuf() *m3uetcpb.StreamInfo {
+	si := svc.PbEvents.GetStreamInfo()
+	if si.IsEmpty() {
+		return nil
+	}
+	return &m3uetcpb.StreamInfo{
+		Title:        si.Title,
+		Artist:       si.Artist,
+		Organization: si.Organization,
+		Bitrate:      int32(si.Bitrate / 1000),
+	}
+}
+
+func broadcastFormatToProtobuf(format string) m3uetcpb.BroadcastFormat {
+	switch format {
+	case config.BroadcastOpus:
+		return m3uetcpb.BroadcastFormat_BROADCAST_OPUS
+	case config.BroadcastMP3:
+		return m3uetcpb.BroadcastFormat_BROADCAST_MP3
+	default:
+		return m3uetcpb.BroadcastFormat_BROADCAST_DEFAULT
+	}
+}
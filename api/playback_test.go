@@ -5,11 +5,12 @@ import (
 	"testing"
 	"time"
 
-	"github.com/go-gst/go-gst/gst"
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
 	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
 	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestGetPlayback(t *testing.T) {
@@ -38,6 +39,12 @@ func TestGetPlayback(t *testing.T) {
 					Album:    "tracks",
 					Artist:   "tracker",
 				},
+				Progress: &m3uetcpb.PlaybackProgress{
+					Position:  30000000000,
+					Duration:  180000000000,
+					Buffering: 100,
+					Seekable:  true,
+				},
 			},
 			false,
 		},
@@ -58,6 +65,12 @@ func TestGetPlayback(t *testing.T) {
 					Album:    "tracks",
 					Artist:   "tracker",
 				},
+				Progress: &m3uetcpb.PlaybackProgress{
+					Position:  30000000000,
+					Duration:  180000000000,
+					Buffering: 100,
+					Seekable:  true,
+				},
 			},
 			false,
 		},
@@ -75,6 +88,12 @@ func TestGetPlayback(t *testing.T) {
 			Album:    "tracks",
 			Artist:   "tracker",
 		},
+		progress: playback.Progress{
+			Position:  30000000000,
+			Duration:  180000000000,
+			Buffering: 100,
+			Seekable:  true,
+		},
 		isPlaying: true,
 	}
 
@@ -106,6 +125,12 @@ func TestGetPlayback(t *testing.T) {
 				assert.Equal(t, exp.Track.Title, res.Track.Title)
 				assert.Equal(t, exp.Track.Album, res.Track.Album)
 				assert.Equal(t, exp.Track.Artist, res.Track.Artist)
+				assert.Equal(t, true, res.Progress != nil)
+				assert.Equal(t, exp.Progress.Position, res.Progress.Position)
+				assert.Equal(t, exp.Progress.Duration, res.Progress.Duration)
+				assert.Equal(t, exp.Progress.Buffering, res.Progress.Buffering)
+				assert.Equal(t, exp.Progress.Seekable, res.Progress.Seekable)
+				assert.Equal(t, exp.Progress.Live, res.Progress.Live)
 			}
 		})
 	}
@@ -134,6 +159,26 @@ func TestExecutePlaybackAction(t *testing.T) {
 			&m3uetcpb.Empty{},
 			true,
 		},
+		{
+			"Execute rate out of range",
+			"api/playback/exec-valid",
+			&m3uetcpb.ExecutePlaybackActionRequest{
+				Action: m3uetcpb.PlaybackAction_PB_RATE,
+				Rate:   4,
+			},
+			&m3uetcpb.Empty{},
+			true,
+		},
+		{
+			"Execute invalid repeat mode",
+			"api/playback/exec-valid",
+			&m3uetcpb.ExecutePlaybackActionRequest{
+				Action: m3uetcpb.PlaybackAction_PB_REPEAT,
+				Repeat: m3uetcpb.RepeatMode(3),
+			},
+			&m3uetcpb.Empty{},
+			true,
+		},
 		{
 			"Execute valid",
 			"api/playback/exec-valid",
@@ -177,6 +222,464 @@ func TestExecutePlaybackAction(t *testing.T) {
 	return
 }
 
+func TestSetVolume(t *testing.T) {
+	table := []testCase{
+		{
+			"Set with empty request",
+			"api/playback/set-volume",
+			&m3uetcpb.SetVolumeRequest{},
+			&m3uetcpb.GetVolumeResponse{},
+			true,
+		},
+		{
+			"Set volume out of range",
+			"api/playback/set-volume",
+			&m3uetcpb.SetVolumeRequest{Volume: proto.Float64(1.5)},
+			&m3uetcpb.GetVolumeResponse{},
+			true,
+		},
+		{
+			"Set volume",
+			"api/playback/set-volume",
+			&m3uetcpb.SetVolumeRequest{Volume: proto.Float64(0.5)},
+			&m3uetcpb.GetVolumeResponse{Volume: 0.5},
+			false,
+		},
+		{
+			"Set mute",
+			"api/playback/set-volume",
+			&m3uetcpb.SetVolumeRequest{Mute: proto.Bool(true)},
+			&m3uetcpb.GetVolumeResponse{Volume: 1, Mute: true},
+			false,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			events := pbEventsMock{volume: 1}
+			svc := PlaybackSvc{PbEvents: &events}
+
+			_, err := svc.SetVolume(context.Background(), tc.req.(*m3uetcpb.SetVolumeRequest))
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			exp := tc.res.(*m3uetcpb.GetVolumeResponse)
+			res, err := svc.GetVolume(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, exp.Volume, res.Volume)
+			assert.Equal(t, exp.Mute, res.Mute)
+		})
+	}
+}
+
+func TestSetOutputDevice(t *testing.T) {
+	table := []testCase{
+		{
+			"Set output without sink",
+			"api/playback/exec-valid",
+			&m3uetcpb.SetOutputDeviceRequest{Device: "default"},
+			&m3uetcpb.OutputDevice{},
+			true,
+		},
+		{
+			"Set null output",
+			"api/playback/exec-valid",
+			&m3uetcpb.SetOutputDeviceRequest{Sink: "fake"},
+			&m3uetcpb.OutputDevice{Sink: "fake", Name: "Null output"},
+			false,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			svc := PlaybackSvc{PbEvents: &pbEventsMock{}}
+
+			_, err := svc.SetOutputDevice(context.Background(), tc.req.(*m3uetcpb.SetOutputDeviceRequest))
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			res, err := svc.GetOutputDevices(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tc.res, res.Active))
+		})
+	}
+}
+
+func TestStartBroadcast(t *testing.T) {
+	table := []testCase{
+		{
+			"Start with invalid format",
+			"api/playback/exec-valid",
+			&m3uetcpb.StartBroadcastRequest{Format: m3uetcpb.BroadcastFormat(5)},
+			&m3uetcpb.Broadcast{},
+			true,
+		},
+		{
+			"Start with negative bitrate",
+			"api/playback/exec-valid",
+			&m3uetcpb.StartBroadcastRequest{Bitrate: -1},
+			&m3uetcpb.Broadcast{},
+			true,
+		},
+		{
+			"Start MP3 broadcast",
+			"api/playback/exec-valid",
+			&m3uetcpb.StartBroadcastRequest{
+				Format:  m3uetcpb.BroadcastFormat_BROADCAST_MP3,
+				Bitrate: 192,
+			},
+			&m3uetcpb.Broadcast{
+				Active:  true,
+				Format:  m3uetcpb.BroadcastFormat_BROADCAST_MP3,
+				Bitrate: 192,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			svc := PlaybackSvc{PbEvents: &pbEventsMock{}}
+
+			_, err := svc.StartBroadcast(context.Background(), tc.req.(*m3uetcpb.StartBroadcastRequest))
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			res, err := svc.GetBroadcast(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tc.res, res.Broadcast))
+		})
+	}
+}
+
+func TestSetSleepTimer(t *testing.T) {
+	table := []testCase{
+		{
+			"Set invalid sleep mode",
+			"api/playback/exec-valid",
+			&m3uetcpb.SetSleepTimerRequest{Mode: m3uetcpb.SleepMode(5)},
+			&m3uetcpb.SleepTimer{},
+			true,
+		},
+		{
+			"Set sleep timer without minutes",
+			"api/playback/exec-valid",
+			&m3uetcpb.SetSleepTimerRequest{Mode: m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES},
+			&m3uetcpb.SleepTimer{},
+			true,
+		},
+		{
+			"Set sleep timer after minutes",
+			"api/playback/exec-valid",
+			&m3uetcpb.SetSleepTimerRequest{
+				Mode:    m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES,
+				Minutes: 30,
+				FadeOut: true,
+			},
+			&m3uetcpb.SleepTimer{
+				Mode:    m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES,
+				Minutes: 30,
+				FadeOut: true,
+			},
+			false,
+		},
+		{
+			"Set sleep timer at end of track",
+			"api/playback/exec-valid",
+			&m3uetcpb.SetSleepTimerRequest{
+				Mode:  m3uetcpb.SleepMode_SLEEP_END_OF_TRACK,
+				Pause: true,
+			},
+			&m3uetcpb.SleepTimer{
+				Mode:  m3uetcpb.SleepMode_SLEEP_END_OF_TRACK,
+				Pause: true,
+			},
+			false,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			svc := PlaybackSvc{PbEvents: &pbEventsMock{}}
+
+			_, err := svc.SetSleepTimer(context.Background(), tc.req.(*m3uetcpb.SetSleepTimerRequest))
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			exp := tc.res.(*m3uetcpb.SleepTimer)
+			res, err := svc.GetPlayback(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			assert.Equal(t, exp.Mode, res.SleepTimer.Mode)
+			assert.Equal(t, exp.Minutes, res.SleepTimer.Minutes)
+			assert.Equal(t, exp.FadeOut, res.SleepTimer.FadeOut)
+			assert.Equal(t, exp.Pause, res.SleepTimer.Pause)
+		})
+	}
+}
+
+func TestGetResumePositions(t *testing.T) {
+	table := []testCase{
+		{
+			"Get all resume positions",
+			"api/playback/get-resume-positions",
+			&m3uetcpb.GetResumePositionsRequest{},
+			&m3uetcpb.GetResumePositionsResponse{
+				Positions: []*m3uetcpb.ResumePosition{
+					{TrackId: 1, Position: 60000000000, Duration: 600000000000},
+					{TrackId: 2, Position: 120000000000, Duration: 900000000000},
+				},
+			},
+			false,
+		},
+		{
+			"Get resume positions for track IDs",
+			"api/playback/get-resume-positions",
+			&m3uetcpb.GetResumePositionsRequest{TrackIds: []int64{2, 3}},
+			&m3uetcpb.GetResumePositionsResponse{
+				Positions: []*m3uetcpb.ResumePosition{
+					{TrackId: 2, Position: 120000000000, Duration: 900000000000},
+				},
+			},
+			false,
+		},
+	}
+
+	svc := PlaybackSvc{PbEvents: &pbEventsMock{}}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			res, err := svc.GetResumePositions(context.Background(), tc.req.(*m3uetcpb.GetResumePositionsRequest))
+			assert.Equal(t, tc.wantErr, err != nil)
+
+			exp := tc.res.(*m3uetcpb.GetResumePositionsResponse)
+			assert.Len(t, res.Positions, len(exp.Positions))
+			for _, e := range exp.Positions {
+				found := false
+				for _, rp := range res.Positions {
+					if rp.TrackId != e.TrackId {
+						continue
+					}
+					found = true
+					assert.Equal(t, e.Position, rp.Position)
+					assert.Equal(t, e.Duration, rp.Duration)
+				}
+				assert.True(t, found, "track %v", e.TrackId)
+			}
+		})
+	}
+}
+
+func TestGetStationHistory(t *testing.T) {
+	table := []testCase{
+		{
+			"Get history for all stations",
+			"api/playback/get-station-history",
+			&m3uetcpb.GetStationHistoryRequest{},
+			&m3uetcpb.GetStationHistoryResponse{
+				Entries: []*m3uetcpb.StationHistory{
+					{Id: 3, Title: "Live Set"},
+					{Id: 2, Title: "Second Song"},
+					{Id: 1, Title: "First Song"},
+				},
+			},
+			false,
+		},
+		{
+			"Get history for a station",
+			"api/playback/get-station-history",
+			&m3uetcpb.GetStationHistoryRequest{Location: "http://radio.example.com/stream"},
+			&m3uetcpb.GetStationHistoryResponse{
+				Entries: []*m3uetcpb.StationHistory{
+					{Id: 2, Title: "Second Song"},
+					{Id: 1, Title: "First Song"},
+				},
+			},
+			false,
+		},
+		{
+			"Get history for a station, with limit",
+			"api/playback/get-station-history",
+			&m3uetcpb.GetStationHistoryRequest{Location: "http://radio.example.com/stream", Limit: 1},
+			&m3uetcpb.GetStationHistoryResponse{
+				Entries: []*m3uetcpb.StationHistory{
+					{Id: 2, Title: "Second Song"},
+				},
+			},
+			false,
+		},
+		{
+			"Negative limit",
+			"api/playback/get-station-history",
+			&m3uetcpb.GetStationHistoryRequest{Limit: -1},
+			&m3uetcpb.GetStationHistoryResponse{},
+			true,
+		},
+	}
+
+	svc := PlaybackSvc{PbEvents: &pbEventsMock{}}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			res, err := svc.GetStationHistory(context.Background(), tc.req.(*m3uetcpb.GetStationHistoryRequest))
+			assert.Equal(t, tc.wantErr, err != nil)
+			if tc.wantErr {
+				return
+			}
+
+			exp := tc.res.(*m3uetcpb.GetStationHistoryResponse)
+			assert.Len(t, res.Entries, len(exp.Entries))
+			for i, e := range exp.Entries {
+				if i >= len(res.Entries) {
+					break
+				}
+				assert.Equal(t, e.Id, res.Entries[i].Id)
+				assert.Equal(t, e.Title, res.Entries[i].Title)
+			}
+		})
+	}
+}
+
+func TestRetryPlaybackFailures(t *testing.T) {
+	table := []testCase{
+		{
+			"Retry all failures",
+			"api/playback/playback-failures",
+			&m3uetcpb.RetryPlaybackFailuresRequest{},
+			&m3uetcpb.GetPlaybackFailuresResponse{},
+			false,
+		},
+		{
+			"Retry one failure",
+			"api/playback/playback-failures",
+			&m3uetcpb.RetryPlaybackFailuresRequest{Ids: []int64{2}},
+			&m3uetcpb.GetPlaybackFailuresResponse{
+				Failures: []*m3uetcpb.PlaybackFailure{
+					{Id: 1, Location: "file:///music/missing.ogg"},
+				},
+			},
+			false,
+		},
+		{
+			"Retry non-existing failure",
+			"api/playback/playback-failures",
+			&m3uetcpb.RetryPlaybackFailuresRequest{Ids: []int64{2, 99}},
+			&m3uetcpb.GetPlaybackFailuresResponse{
+				Failures: []*m3uetcpb.PlaybackFailure{
+					{Id: 1, Location: "file:///music/missing.ogg"},
+					{Id: 2, Location: "http://radio.example.com/gone"},
+				},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			events := pbEventsMock{}
+			svc := PlaybackSvc{PbEvents: &events}
+
+			_, err := svc.RetryPlaybackFailures(context.Background(), tc.req.(*m3uetcpb.RetryPlaybackFailuresRequest))
+			assert.Equal(t, tc.wantErr, err != nil)
+
+			exp := tc.res.(*m3uetcpb.GetPlaybackFailuresResponse)
+			res, err := svc.GetPlaybackFailures(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			assert.Len(t, res.Failures, len(exp.Failures))
+			assert.Len(t, events.played, 2-len(exp.Failures))
+			for _, pf := range res.Failures {
+				assert.NotContains(t, events.played, pf.Location)
+			}
+		})
+	}
+}
+
+func TestClearPlaybackFailures(t *testing.T) {
+	table := []testCase{
+		{
+			"Clear all failures",
+			"api/playback/playback-failures",
+			&m3uetcpb.ClearPlaybackFailuresRequest{},
+			&m3uetcpb.GetPlaybackFailuresResponse{},
+			false,
+		},
+		{
+			"Clear one failure",
+			"api/playback/playback-failures",
+			&m3uetcpb.ClearPlaybackFailuresRequest{Ids: []int64{1}},
+			&m3uetcpb.GetPlaybackFailuresResponse{
+				Failures: []*m3uetcpb.PlaybackFailure{
+					{Id: 2, Location: "http://radio.example.com/gone"},
+				},
+			},
+			false,
+		},
+	}
+
+	svc := PlaybackSvc{PbEvents: &pbEventsMock{}}
+
+	for _, tc := range table {
+		t.Run(tc.name, func(t *testing.T) {
+			tests.SetupTest(t, tc.fixturesDir)
+			t.Cleanup(func() { tests.TeardownTest(t) })
+
+			_, err := svc.ClearPlaybackFailures(context.Background(), tc.req.(*m3uetcpb.ClearPlaybackFailuresRequest))
+			assert.Equal(t, tc.wantErr, err != nil)
+
+			exp := tc.res.(*m3uetcpb.GetPlaybackFailuresResponse)
+			res, err := svc.GetPlaybackFailures(context.Background(), &m3uetcpb.Empty{})
+			assert.NoError(t, err)
+			assert.Len(t, res.Failures, len(exp.Failures))
+			for i, pf := range exp.Failures {
+				if i < len(res.Failures) {
+					assert.Equal(t, pf.Id, res.Failures[i].Id)
+					assert.Equal(t, pf.Location, res.Failures[i].Location)
+				}
+			}
+		})
+	}
+}
+
 func TestPlaybackToProtobuf(t *testing.T) {
 	pb := models.Playback{
 		ID:        1,
@@ -205,23 +708,64 @@ func TestPlaybackToProtobuf(t *testing.T) {
 type pbEventsMock struct {
 	pb             *models.Playback
 	t              *models.Track
-	state          gst.State
+	rate           float64
+	repeat         models.RepeatMode
+	shuffle        bool
+	sleepTimer     playback.SleepTimer
+	output         playback.OutputDevice
+	broadcast      playback.BroadcastStatus
+	streamInfo     playback.StreamInfo
+	progress       playback.Progress
+	played         []string
+	state          playback.State
 	hasNextStream  bool
+	isCrossfade    bool
 	isPaused       bool
 	isPlaying      bool
 	isReady        bool
 	isStreaming    bool
 	isStopped      bool
+	volume         float64
+	mute           bool
 	nextStreamErr  error
 	pauseStreamErr error
 }
 
 func (e *pbEventsMock) GetPlayback() (pb *models.Playback, t *models.Track) { return e.pb, e.t }
 
-func (e *pbEventsMock) GetState() gst.State { return e.state }
+func (e *pbEventsMock) GetBroadcast() playback.BroadcastStatus { return e.broadcast }
+
+func (e *pbEventsMock) GetOutputDevice() playback.OutputDevice { return e.output }
+
+func (e *pbEventsMock) GetOutputDevices() []playback.OutputDevice {
+	return []playback.OutputDevice{
+		{Sink: "auto", Name: "Automatic"},
+		{Sink: "fake", Name: "Null output"},
+	}
+}
+
+func (e *pbEventsMock) GetProgress() playback.Progress { return e.progress }
+
+func (e *pbEventsMock) GetRate() float64 { return e.rate }
+
+func (e *pbEventsMock) GetStreamInfo() playback.StreamInfo { return e.streamInfo }
+
+func (e *pbEventsMock) GetRepeatMode() models.RepeatMode { return e.repeat }
+
+func (e *pbEventsMock) GetShuffle() bool { return e.shuffle }
+
+func (e *pbEventsMock) GetSleepTimer() (playback.SleepTimer, time.Duration) {
+	return e.sleepTimer, 0
+}
+
+func (e *pbEventsMock) GetState() playback.State { return e.state }
+
+func (e *pbEventsMock) GetVolume() (volume float64, mute bool) { return e.volume, e.mute }
 
 func (e *pbEventsMock) HasNextStream() bool { return e.hasNextStream }
 
+func (e *pbEventsMock) IsCrossfadeEnabled() bool { return e.isCrossfade }
+
 func (e *pbEventsMock) IsPaused() bool { return e.isPaused }
 
 func (e *pbEventsMock) IsPlaying() bool { return e.isPlaying }
@@ -236,16 +780,57 @@ func (e *pbEventsMock) NextStream() (err error) { return e.nextStreamErr }
 
 func (e *pbEventsMock) PauseStream(off bool) (err error) { return e.pauseStreamErr }
 
-func (p *pbEventsMock) PlayStreams(force bool, locations []string, ids []int64) {}
+func (p *pbEventsMock) PlayStreams(force bool, locations []string, ids []int64) {
+	p.played = append(p.played, locations...)
+}
 
 func (p *pbEventsMock) PreviousStream() {}
 
 func (p *pbEventsMock) QuitPlayingFromBar(pl *models.Playlist) {}
 
+func (p *pbEventsMock) ReloadEqualizer() {}
+
 func (p *pbEventsMock) SeekInStream(pos int64) {}
 
+func (p *pbEventsMock) SetCrossfade(enabled bool) { p.isCrossfade = enabled }
+
+func (p *pbEventsMock) SetFromBeginning() {}
+
+func (p *pbEventsMock) SetMute(mute bool) { p.mute = mute }
+
+func (p *pbEventsMock) SetOutputDevice(od playback.OutputDevice) error {
+	p.output = od
+	return nil
+}
+
+func (p *pbEventsMock) SetRate(rate float64) { p.rate = rate }
+
+func (p *pbEventsMock) SetRepeatMode(idx models.PerspectiveIndex, rm models.RepeatMode) error {
+	p.repeat = rm
+	return nil
+}
+
+func (p *pbEventsMock) SetShuffle(idx models.PerspectiveIndex, shuffle bool) error {
+	p.shuffle = shuffle
+	return nil
+}
+
+func (p *pbEventsMock) SetSleepTimer(st playback.SleepTimer) error {
+	p.sleepTimer = st
+	return nil
+}
+
+func (p *pbEventsMock) SetVolume(volume float64) { p.volume = volume }
+
+func (p *pbEventsMock) StartBroadcast(format string, bitrate int) error {
+	p.broadcast = playback.BroadcastStatus{Active: true, Format: format, Bitrate: bitrate}
+	return nil
+}
+
 func (p *pbEventsMock) StopAll() {}
 
+func (p *pbEventsMock) StopBroadcast() { p.broadcast = playback.BroadcastStatus{} }
+
 func (p *pbEventsMock) StopStream() {}
 
 func (p *pbEventsMock) TryPlayingFromBar(pl *models.Playlist, position int) {}
@@ -2,10 +2,9 @@ package api
 
 import (
 	"context"
-	"time"
 
-	"github.com/jwmwalrus/gear-pieces/idler"
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/jwmwalrus/m3u-etcetera/internal/alive"
 )
 
 // RootSvc implements the m3uetcpb.RootSvcServer interface.
@@ -16,13 +15,7 @@ type RootSvc struct {
 func (*RootSvc) Off(_ context.Context,
 	req *m3uetcpb.OffRequest) (*m3uetcpb.OffResponse, error) {
 
-	idler.DoTerminate(req.Force)
-	go func() {
-		ctx, cancel := context.WithCancel(context.Background())
-		defer cancel()
-		go idler.Idle(ctx)
-		time.Sleep(5 * time.Second)
-	}()
+	alive.TurnOff(req.Force)
 	return &m3uetcpb.OffResponse{GoingOff: true}, nil
 }
 
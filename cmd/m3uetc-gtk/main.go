@@ -44,6 +44,7 @@ func main() {
 	app.Connect("activate", func() {
 		if activated {
 			fmt.Printf("Primary instance already active\n")
+			window.Present()
 			return
 		}
 
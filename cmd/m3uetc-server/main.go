@@ -12,7 +12,10 @@ import (
 	"github.com/jwmwalrus/m3u-etcetera/internal/base"
 	"github.com/jwmwalrus/m3u-etcetera/internal/database"
 	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+	_ "github.com/jwmwalrus/m3u-etcetera/internal/playback/gstreamer"
+	"github.com/jwmwalrus/m3u-etcetera/internal/scrobble"
 	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+	"github.com/jwmwalrus/m3u-etcetera/internal/watcher"
 	rtc "github.com/jwmwalrus/rtcycler"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -31,6 +34,10 @@ func main() {
 
 	rtc.RegisterUnloader(playback.StartEngine())
 
+	rtc.RegisterUnloader(scrobble.Start())
+
+	rtc.RegisterUnloader(watcher.Start())
+
 	slog.Info("Starting server...")
 
 	port := base.Conf.Server.Port
@@ -52,6 +59,7 @@ func main() {
 	m3uetcpb.RegisterQuerySvcServer(s, &api.QuerySvc{})
 	m3uetcpb.RegisterPlaybarSvcServer(s, &api.PlaybarSvc{PbEvents: pbEvents})
 	m3uetcpb.RegisterPerspectiveSvcServer(s, &api.PerspectiveSvc{})
+	m3uetcpb.RegisterEqualizerSvcServer(s, &api.EqualizerSvc{PbEvents: pbEvents})
 
 	reflection.Register(s)
 
@@ -73,6 +73,7 @@ func main() {
 			task.Playtrack(),
 			task.Playgroup(),
 			task.Perspective(),
+			task.Equalizer(),
 		},
 		Action: task.DefaultAction,
 	}
@@ -0,0 +1,19 @@
+REM GENRE "Progressive Rock"
+REM DATE 1973
+REM REPLAYGAIN_ALBUM_GAIN -6.50 dB
+REM REPLAYGAIN_ALBUM_PEAK 0.988
+PERFORMER "Some Band"
+TITLE "Some Album"
+FILE "album.wav" WAVE
+  TRACK 01 AUDIO
+    TITLE "First Song"
+    INDEX 01 00:00:00
+  TRACK 02 AUDIO
+    TITLE "Second Song"
+    PERFORMER "Guest Singer"
+    REM REPLAYGAIN_TRACK_GAIN -7.25 dB
+    INDEX 00 04:10:50
+    INDEX 01 04:12:00
+  TRACK 03 AUDIO
+    TITLE "Caf"
+    INDEX 01 09:30:37
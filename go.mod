@@ -20,6 +20,7 @@ require (
 	github.com/rodaine/table v1.3.0
 	github.com/stretchr/testify v1.10.0
 	github.com/urfave/cli/v3 v3.1.1
+	golang.org/x/sys v0.32.0
 	golang.org/x/text v0.24.0
 	google.golang.org/grpc v1.71.1
 	google.golang.org/protobuf v1.36.6
@@ -41,7 +42,6 @@ require (
 	golang.org/x/exp v0.0.0-20250305212735-054e65f0b394 // indirect
 	golang.org/x/net v0.38.0 // indirect
 	golang.org/x/sync v0.13.0 // indirect
-	golang.org/x/sys v0.32.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250404141209-ee84b53bf3d0 // indirect
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
@@ -36,6 +36,22 @@ func GetCheckButton(id string) (c *gtk.CheckButton, err error) {
 	return
 }
 
+// GetCheckMenuItem -.
+func GetCheckMenuItem(id string) (cmi *gtk.CheckMenuItem, err error) {
+	obj := app.GetObject(id)
+	if obj == nil {
+		err = fmt.Errorf("Unable to get check menu item")
+		return
+	}
+	cmi, ok := obj.Cast().(*gtk.CheckMenuItem)
+	if !ok {
+		err = fmt.Errorf("Unable to create check menu item")
+		return
+	}
+
+	return
+}
+
 // GetComboBoxText -.
 func GetComboBoxText(id string) (cbt *gtk.ComboBoxText, err error) {
 	obj := app.GetObject(id)
@@ -383,3 +399,19 @@ func GetTreeView(id string) (s *gtk.TreeView, err error) {
 	}
 	return
 }
+
+// GetVolumeButton -.
+func GetVolumeButton(id string) (btn *gtk.VolumeButton, err error) {
+	obj := app.GetObject(id)
+	if obj == nil {
+		err = fmt.Errorf("Unable to get volume-button object")
+		return
+	}
+
+	btn, ok := obj.Cast().(*gtk.VolumeButton)
+	if !ok {
+		err = fmt.Errorf("Unable to create volume-button")
+		return
+	}
+	return
+}
@@ -22,6 +22,32 @@ func ExecutePlaybackAction(req *m3uetcpb.ExecutePlaybackActionRequest) (err erro
 	return
 }
 
+// SetSleepTimer -.
+func SetSleepTimer(req *m3uetcpb.SetSleepTimerRequest) (err error) {
+	cc, err := getClientConn1()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := m3uetcpb.NewPlaybackSvcClient(cc)
+	_, err = cl.SetSleepTimer(context.Background(), req)
+	return
+}
+
+// SetVolume -.
+func SetVolume(req *m3uetcpb.SetVolumeRequest) (err error) {
+	cc, err := getClientConn1()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := m3uetcpb.NewPlaybackSvcClient(cc)
+	_, err = cl.SetVolume(context.Background(), req)
+	return
+}
+
 func subscribeToPlayback() {
 	slog.Info("Subscribing to playback")
 
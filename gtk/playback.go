@@ -2,6 +2,7 @@ package gtkui
 
 import (
 	"log/slog"
+	"math"
 
 	"github.com/diamondburned/gotk4/pkg/gdk/v3"
 	"github.com/diamondburned/gotk4/pkg/gtk/v3"
@@ -10,6 +11,7 @@ import (
 	"github.com/jwmwalrus/m3u-etcetera/gtk/builder"
 	"github.com/jwmwalrus/m3u-etcetera/gtk/dialer"
 	"github.com/jwmwalrus/m3u-etcetera/gtk/store"
+	"google.golang.org/protobuf/proto"
 )
 
 func setupPlayback(signals *builder.Signals) (err error) {
@@ -49,6 +51,47 @@ func setupPlayback(signals *builder.Signals) (err error) {
 			go onControlClicked(btn, m3uetcpb.PlaybackAction_PB_NEXT)
 		},
 	)
+	(*signals).AddDetail(
+		"control_toggle_shuffle",
+		"clicked",
+		onShuffleClicked,
+	)
+	(*signals).AddDetail(
+		"control_toggle_repeat",
+		"clicked",
+		onRepeatClicked,
+	)
+	(*signals).AddDetail(
+		"control_sleep",
+		"clicked",
+		onSleepClicked,
+	)
+	for id, req := range map[string]*m3uetcpb.SetSleepTimerRequest{
+		"control_sleep_menu_off": {Mode: m3uetcpb.SleepMode_SLEEP_OFF},
+		"control_sleep_menu_15":  {Mode: m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES, Minutes: 15},
+		"control_sleep_menu_30":  {Mode: m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES, Minutes: 30},
+		"control_sleep_menu_60":  {Mode: m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES, Minutes: 60},
+		"control_sleep_menu_track": {
+			Mode: m3uetcpb.SleepMode_SLEEP_END_OF_TRACK,
+		},
+		"control_sleep_menu_playlist": {
+			Mode: m3uetcpb.SleepMode_SLEEP_END_OF_PLAYLIST,
+		},
+		"control_sleep_menu_queue": {
+			Mode: m3uetcpb.SleepMode_SLEEP_END_OF_QUEUE,
+		},
+	} {
+		(*signals).AddDetail(
+			id,
+			"activate",
+			func(mi *gtk.MenuItem) { onSleepActivated(req) },
+		)
+	}
+	(*signals).AddDetail(
+		"control_volume",
+		"value-changed",
+		onVolumeChanged,
+	)
 	(*signals).AddDetail(
 		"progress_eb",
 		"button-press-event",
@@ -89,3 +132,84 @@ func onProgressBarClicked(eb *gtk.EventBox, event *gdk.Event) {
 		onerror.Log(dialer.ExecutePlaybackAction(req))
 	}()
 }
+
+// onRepeatClicked cycles through the repeat modes.
+func onRepeatClicked(btn *gtk.ToolButton) {
+	var repeat m3uetcpb.RepeatMode
+	switch store.PbData.Repeat() {
+	case m3uetcpb.RepeatMode_REPEAT_NONE:
+		repeat = m3uetcpb.RepeatMode_REPEAT_PLAYLIST
+	case m3uetcpb.RepeatMode_REPEAT_PLAYLIST:
+		repeat = m3uetcpb.RepeatMode_REPEAT_TRACK
+	default:
+		repeat = m3uetcpb.RepeatMode_REPEAT_NONE
+	}
+
+	go func() {
+		req := &m3uetcpb.ExecutePlaybackActionRequest{
+			Action:      m3uetcpb.PlaybackAction_PB_REPEAT,
+			Repeat:      repeat,
+			Perspective: store.GetActivePerspective(),
+		}
+		onerror.Log(dialer.ExecutePlaybackAction(req))
+	}()
+}
+
+// onShuffleClicked toggles the shuffle mode.
+func onShuffleClicked(btn *gtk.ToolButton) {
+	shuffle := !store.PbData.Shuffle()
+
+	go func() {
+		req := &m3uetcpb.ExecutePlaybackActionRequest{
+			Action:      m3uetcpb.PlaybackAction_PB_SHUFFLE,
+			Shuffle:     shuffle,
+			Perspective: store.GetActivePerspective(),
+		}
+		onerror.Log(dialer.ExecutePlaybackAction(req))
+	}()
+}
+
+// onSleepClicked shows the sleep timer menu.
+func onSleepClicked(btn *gtk.ToolButton) {
+	menu, err := builder.GetMenu("control_sleep_menu")
+	if err != nil {
+		slog.With(
+			"menu", "control_sleep_menu",
+			"error", err,
+		).Error("Failed to get menu from builder")
+		return
+	}
+	menu.PopupAtPointer(nil)
+}
+
+// onSleepActivated sets the sleep timer from the chosen menu item.
+func onSleepActivated(req *m3uetcpb.SetSleepTimerRequest) {
+	req = proto.Clone(req).(*m3uetcpb.SetSleepTimerRequest)
+
+	for id, opt := range map[string]*bool{
+		"control_sleep_menu_fade":  &req.FadeOut,
+		"control_sleep_menu_pause": &req.Pause,
+	} {
+		cmi, err := builder.GetCheckMenuItem(id)
+		if err != nil {
+			slog.Error("Failed to get check menu item from builder", "id", id, "error", err)
+			continue
+		}
+		*opt = cmi.Active()
+	}
+
+	go func() {
+		onerror.Log(dialer.SetSleepTimer(req))
+	}()
+}
+
+func onVolumeChanged(btn *gtk.VolumeButton, value float64) {
+	if volume, _ := store.PbData.Volume(); math.Abs(volume-value) < 0.005 {
+		return
+	}
+
+	go func() {
+		req := &m3uetcpb.SetVolumeRequest{Volume: proto.Float64(value)}
+		onerror.Log(dialer.SetVolume(req))
+	}()
+}
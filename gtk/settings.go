@@ -252,6 +252,11 @@ func (osm *onSettingsMenu) createCollectionDialogs() (err error) {
 		return
 	}
 
+	watchedrw, err := cr.NewActivatable(store.CColWatched)
+	if err != nil {
+		return
+	}
+
 	rescanrw, err := cr.NewActivatable(store.CColActionRescan)
 	if err != nil {
 		return
@@ -274,6 +279,7 @@ func (osm *onSettingsMenu) createCollectionDialogs() (err error) {
 		{store.CColActionRemove, removerw, true},
 		{store.CColDisabled, disabledrw, true},
 		{store.CColRemote, remoterw, true},
+		{store.CColWatched, watchedrw, true},
 		{store.CColDescription, descriptionrw, true},
 		{store.CColLocation, textro, false},
 		{store.CColRemoteLocation, remotelocationrw, true},
@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/diamondburned/gotk4/pkg/glib/v2"
 	"github.com/diamondburned/gotk4/pkg/gtk/v3"
@@ -42,6 +43,7 @@ type collectionData struct {
 	subscriptionID string
 	collection     []*m3uetcpb.Collection
 	track          []*m3uetcpb.Track
+	progress       *m3uetcpb.ScanProgress // of the running scan, if any
 
 	mu sync.RWMutex
 }
@@ -156,6 +158,7 @@ func (cd *collectionData) FindUpdateCollectionRequests() ([]*m3uetcpb.UpdateColl
 				CColRemoteLocation,
 				CColDisabled,
 				CColRemote,
+				CColWatched,
 			},
 		)
 		if err != nil {
@@ -206,6 +209,13 @@ func (cd *collectionData) FindUpdateCollectionRequests() ([]*m3uetcpb.UpdateColl
 				req.MakeLocal = true
 			}
 
+			watched := row[CColWatched].(bool)
+			if watched {
+				req.Watch = true
+			} else {
+				req.Unwatch = true
+			}
+
 			requests = append(requests, req)
 			break
 		}
@@ -223,6 +233,12 @@ func (cd *collectionData) ProcessSubscriptionResponse(res *m3uetcpb.SubscribeToC
 		cd.subscriptionID = res.SubscriptionId
 	}
 
+	if res.Event == m3uetcpb.CollectionEvent_CE_SCANNING_PROGRESS {
+		cd.progress = res.GetProgress()
+		glib.IdleAdd(cd.updateScanningProgress)
+		return
+	}
+
 	cTree.setLastEvent(res.Event)
 	switch res.Event {
 	case m3uetcpb.CollectionEvent_CE_INITIAL:
@@ -243,6 +259,7 @@ func (cd *collectionData) ProcessSubscriptionResponse(res *m3uetcpb.SubscribeToC
 		cTree.setScanningMode(true)
 	case m3uetcpb.CollectionEvent_CE_SCANNING_DONE:
 		cTree.setScanningMode(false)
+		cd.progress = nil
 	}
 
 	glib.IdleAdd(cd.updateCollectionModel)
@@ -382,6 +399,7 @@ func (cd *collectionData) updateCollectionModel() bool {
 					int(CColPerspective),
 					int(CColDisabled),
 					int(CColRemote),
+					int(CColWatched),
 					int(CColTracks),
 					int(CColTracksView),
 					int(CColActionRescan),
@@ -395,6 +413,7 @@ func (cd *collectionData) updateCollectionModel() bool {
 					*glib.NewValue(persp),
 					*glib.NewValue(c.Disabled),
 					*glib.NewValue(c.Remote),
+					*glib.NewValue(c.Watched),
 					*glib.NewValue(c.Tracks),
 					*glib.NewValue(tracks),
 					*glib.NewValue(false),
@@ -426,6 +445,20 @@ func (cd *collectionData) updateScanningProgress() bool {
 	cd.mu.RLock()
 	defer cd.mu.RUnlock()
 
+	if p := cd.progress; p != nil && p.Total > 0 {
+		text := fmt.Sprintf("Scanning: %v/%v files", p.Seen, p.Total)
+		if p.Paused {
+			text += " (paused)"
+		} else if p.Eta > 0 {
+			eta := time.Duration(p.Eta) * time.Nanosecond
+			text += fmt.Sprintf(" (%v left)", eta.Truncate(time.Second))
+		}
+		cProgress.SetVisible(true)
+		cProgress.SetFraction(float64(p.Seen) / float64(p.Total))
+		cProgress.SetText(text)
+		return false
+	}
+
 	scanned := 0
 	for _, c := range cd.collection {
 		if c.Scanned != 100 && int(c.Scanned) > scanned {
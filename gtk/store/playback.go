@@ -2,6 +2,7 @@ package store
 
 import (
 	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"sync"
@@ -31,6 +32,10 @@ type playbackData struct {
 	cover                        *gtk.Image
 	logoPixbuf                   *gdkpixbuf.Pixbuf
 	playBtn                      *gtk.ToolButton
+	repeatBtn                    *gtk.ToolButton
+	shuffleBtn                   *gtk.ToolButton
+	sleepBtn                     *gtk.ToolButton
+	volumeBtn                    *gtk.VolumeButton
 	title, artist, source, extra *gtk.Label
 	prog                         *gtk.ProgressBar
 
@@ -64,6 +69,30 @@ func (pbd *playbackData) CurrentPlayback() (pb *m3uetcpb.Playback,
 	return
 }
 
+// Repeat returns the current repeat mode.
+func (pbd *playbackData) Repeat() m3uetcpb.RepeatMode {
+	pbd.mu.RLock()
+	defer pbd.mu.RUnlock()
+
+	return pbd.res.Repeat
+}
+
+// Shuffle returns true if shuffle is enabled.
+func (pbd *playbackData) Shuffle() bool {
+	pbd.mu.RLock()
+	defer pbd.mu.RUnlock()
+
+	return pbd.res.Shuffle
+}
+
+// Volume returns the current volume and mute settings.
+func (pbd *playbackData) Volume() (volume float64, mute bool) {
+	pbd.mu.RLock()
+	defer pbd.mu.RUnlock()
+
+	return pbd.res.Volume, pbd.res.Mute
+}
+
 func (pbd *playbackData) SubscriptionID() string {
 	pbd.mu.RLock()
 	defer pbd.mu.RUnlock()
@@ -122,6 +151,22 @@ func (pbd *playbackData) SetPlaybackUI() (err error) {
 	if err != nil {
 		return
 	}
+	pbd.repeatBtn, err = builder.GetToolButton("control_toggle_repeat")
+	if err != nil {
+		return
+	}
+	pbd.shuffleBtn, err = builder.GetToolButton("control_toggle_shuffle")
+	if err != nil {
+		return
+	}
+	pbd.sleepBtn, err = builder.GetToolButton("control_sleep")
+	if err != nil {
+		return
+	}
+	pbd.volumeBtn, err = builder.GetVolumeButton("control_volume")
+	if err != nil {
+		return
+	}
 
 	for _, v := range base.Conf.GTK.Playback.CoverFilenames {
 		for _, ext := range []string{".jpeg", ".jpg", ".png"} {
@@ -205,9 +250,14 @@ func (pbd *playbackData) updatePlayback() bool {
 			iconName = "media-playback-start"
 		}
 		pbd.playBtn.SetIconName(iconName)
+		pbd.updateRepeat()
+		pbd.updateShuffle()
+		pbd.updateSleep()
 
 		var location, title, artist, album string
 		var duration, position int64
+		var buffering int32 = 100
+		var live bool
 
 		oldTrackID = pbd.trackID
 		if pbd.res.IsStreaming {
@@ -219,13 +269,29 @@ func (pbd *playbackData) updatePlayback() bool {
 			album = pbd.res.Track.Album
 			duration = pbd.res.Track.Duration
 			position = pbd.res.Playback.Skip
+			if prog := pbd.res.Progress; prog != nil {
+				if prog.Duration > 0 {
+					duration = prog.Duration
+				}
+				position = prog.Position
+				buffering = prog.Buffering
+				live = prog.Live
+			}
 		} else {
 			pbd.trackID = 0
 			location = ""
 			title, artist, album = "", "", ""
 		}
 
-		if duration > 0 {
+		switch {
+		case pbd.res.IsStreaming && buffering < 100:
+			pbd.prog.SetFraction(float64(buffering) / 100)
+			pbd.prog.SetText(fmt.Sprintf("Buffering %d%%", buffering))
+		case pbd.res.IsStreaming && live:
+			pos := time.Duration(position) * time.Nanosecond
+			pbd.prog.SetFraction(float64(0))
+			pbd.prog.SetText(fmt.Sprintf("%v (live)", pos.Truncate(time.Second)))
+		case duration > 0:
 			pos := time.Duration(position) * time.Nanosecond
 			dur := time.Duration(duration) * time.Nanosecond
 			pbd.prog.SetFraction(float64(position) / float64(duration))
@@ -236,7 +302,7 @@ func (pbd *playbackData) updatePlayback() bool {
 					dur.Truncate(time.Second),
 				),
 			)
-		} else {
+		default:
 			pbd.prog.SetFraction(float64(0))
 			pbd.prog.SetText("Not Playing")
 		}
@@ -276,8 +342,78 @@ func (pbd *playbackData) updatePlayback() bool {
 	}
 	pbd.mu.Unlock()
 
+	// NOTE: setting the value emits value-changed, whose handler reads
+	// the volume, so this must be done outside the lock
+	pbd.updateVolume()
+
 	if oldTrackID != pbd.getTrackID() {
 		BData.updatePlaybarModel()
 	}
 	return false
 }
+
+func (pbd *playbackData) updateRepeat() {
+	var iconName, tooltip string
+	switch pbd.res.Repeat {
+	case m3uetcpb.RepeatMode_REPEAT_TRACK:
+		iconName, tooltip = "media-playlist-repeat-song", "Repeat: Track"
+	case m3uetcpb.RepeatMode_REPEAT_PLAYLIST:
+		iconName, tooltip = "media-playlist-repeat", "Repeat: Playlist"
+	default:
+		iconName, tooltip = "media-playlist-consecutive", "Repeat: None"
+	}
+	pbd.repeatBtn.SetIconName(iconName)
+	pbd.repeatBtn.SetTooltipText(tooltip)
+}
+
+func (pbd *playbackData) updateShuffle() {
+	tooltip, opacity := "Shuffle: Off", 0.5
+	if pbd.res.Shuffle {
+		tooltip, opacity = "Shuffle: On", 1.0
+	}
+	pbd.shuffleBtn.SetTooltipText(tooltip)
+	pbd.shuffleBtn.SetOpacity(opacity)
+}
+
+func (pbd *playbackData) updateSleep() {
+	st := pbd.res.SleepTimer
+	if st == nil {
+		st = &m3uetcpb.SleepTimer{}
+	}
+
+	var tooltip string
+	switch st.Mode {
+	case m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES:
+		remaining := time.Duration(st.Remaining).Round(time.Second)
+		tooltip = "Sleep timer: in " + remaining.String()
+	case m3uetcpb.SleepMode_SLEEP_END_OF_TRACK:
+		tooltip = "Sleep timer: at end of track"
+	case m3uetcpb.SleepMode_SLEEP_END_OF_PLAYLIST:
+		tooltip = "Sleep timer: at end of playlist"
+	case m3uetcpb.SleepMode_SLEEP_END_OF_QUEUE:
+		tooltip = "Sleep timer: at end of queue"
+	default:
+		tooltip = "Sleep timer: Off"
+	}
+
+	opacity := 1.0
+	if st.Mode == m3uetcpb.SleepMode_SLEEP_OFF {
+		opacity = 0.5
+	}
+	pbd.sleepBtn.SetTooltipText(tooltip)
+	pbd.sleepBtn.SetOpacity(opacity)
+}
+
+func (pbd *playbackData) updateVolume() {
+	volume, mute := pbd.Volume()
+
+	if math.Abs(pbd.volumeBtn.Value()-volume) >= 0.005 {
+		pbd.volumeBtn.SetValue(volume)
+	}
+
+	if mute {
+		pbd.volumeBtn.SetTooltipText("Muted")
+		return
+	}
+	pbd.volumeBtn.SetTooltipText(fmt.Sprintf("Volume: %.0f%%", volume*100))
+}
@@ -57,6 +57,7 @@ const (
 	CColPerspective
 	CColDisabled
 	CColRemote
+	CColWatched
 	CColScanned
 	CColTracks
 	CColTracksView
@@ -238,6 +239,7 @@ func init() {
 	CColumns[CColPerspective] = columnDef{Name: "Perspective", colType: glib.TypeString}
 	CColumns[CColDisabled] = columnDef{Name: "Disabled", colType: glib.TypeBoolean, activatable: true}
 	CColumns[CColRemote] = columnDef{Name: "Remote", colType: glib.TypeBoolean, activatable: true}
+	CColumns[CColWatched] = columnDef{Name: "Watched", colType: glib.TypeBoolean, activatable: true}
 	CColumns[CColScanned] = columnDef{Name: "Scanned", colType: glib.TypeInt, activatable: true}
 	CColumns[CColTracks] = columnDef{Name: "# Tracks", colType: glib.TypeInt64}
 
@@ -1,6 +1,7 @@
 package alive
 
 import (
+	"context"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/jwmwalrus/bnp/onerror"
+	"github.com/jwmwalrus/gear-pieces/idler"
 	rtc "github.com/jwmwalrus/rtcycler"
 )
 
@@ -49,6 +51,18 @@ func Serve(o ...Option) error {
 	return a.serve()
 }
 
+// TurnOff terminates the server running in this process, once it is idle,
+// or right away if force is true.
+func TurnOff(force bool) {
+	idler.DoTerminate(force)
+	go func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go idler.Idle(ctx)
+		time.Sleep(5 * time.Second)
+	}()
+}
+
 // readServerAlive reads the server alive flag file.
 func readServerAlive() {
 	slog.Debug("Reading server status from file")
@@ -3,8 +3,10 @@ package base
 import (
 	"path/filepath"
 	"slices"
+	"strings"
 
 	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
 )
 
 // Playlist extensions.
@@ -13,13 +15,17 @@ const (
 	SupportedFileExtensionM4A  = ".m4a"
 	SupportedFileExtensionOGG  = ".ogg"
 	SupportedFileExtensionFLAC = ".flac"
+	SupportedFileExtensionAPE  = ".ape"
+
+	SupportedCueSheetExtension = ".cue"
 
 	SupportedPlaylistExtensionM3U  = ".m3u"
 	SupportedPlaylistExtensionM3U8 = ".m3u8"
 	SupportedPlaylistExtensionPLS  = ".pls"
 
-	SupportedURISchemeFile = "file"
-	SupportedURISchemeHTTP = "http"
+	SupportedURISchemeFile  = "file"
+	SupportedURISchemeHTTP  = "http"
+	SupportedURISchemeHTTPS = "https"
 )
 
 var (
@@ -29,6 +35,7 @@ var (
 		SupportedFileExtensionM4A,
 		SupportedFileExtensionOGG,
 		SupportedFileExtensionFLAC,
+		SupportedFileExtensionAPE,
 	}
 
 	// SupportedPlaylistExtensions -.
@@ -42,6 +49,7 @@ var (
 	SupportedURISchemes = []string{
 		SupportedURISchemeFile,
 		SupportedURISchemeHTTP,
+		SupportedURISchemeHTTPS,
 	}
 
 	// SupportedMIMETypes -.
@@ -67,6 +75,12 @@ var (
 		"audio/x-flac",
 		"application/x-flac",
 		"audio/flac",
+		"audio/x-ape",
+		"audio/ape",
+		"audio/x-mpegurl",
+		"audio/mpegurl",
+		"application/vnd.apple.mpegurl",
+		"audio/x-scpls",
 	}
 
 	// IgnoredFileExtensions -.
@@ -92,7 +106,7 @@ func CheckUnsupportedFiles(files []string) (unsupp []string) {
 
 // IsSupportedURL returns true if the path is supported.
 func IsSupportedURL(s string) bool {
-	path, err := urlstr.URLToPath(s)
+	path, err := urlstr.URLToPath(cuesheet.Source(s))
 	if err != nil {
 		return false
 	}
@@ -120,6 +134,11 @@ func IsSupportedPlaylist(path string) bool {
 	return slices.Contains(SupportedPlaylistExtensions, filepath.Ext(path))
 }
 
+// IsCueSheet returns true if the path is a cue sheet.
+func IsCueSheet(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), SupportedCueSheetExtension)
+}
+
 // IsIgnoredFile returns true if the path should be ignored.
 func IsIgnoredFile(path string) bool {
 	return slices.Contains(IgnoredFileExtensions, filepath.Ext(path))
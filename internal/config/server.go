@@ -1,6 +1,9 @@
 package config
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 const (
 	// DefaultServerScheme -.
@@ -20,6 +23,77 @@ const (
 
 	// DefaultQueryMaxLimit -.
 	DefaultQueryMaxLimit = 1023
+
+	// DefaultCrossfadeDuration -.
+	DefaultCrossfadeDuration = 5
+
+	// DefaultPlaybackBackend -.
+	DefaultPlaybackBackend = PlaybackBackendGStreamer
+
+	// DefaultReplayGainMode -.
+	DefaultReplayGainMode = ReplayGainOff
+
+	// DefaultProgressInterval -.
+	DefaultProgressInterval = 1000
+
+	// DefaultStreamHistoryLimit -.
+	DefaultStreamHistoryLimit = 100
+
+	// DefaultBroadcastPort -.
+	DefaultBroadcastPort = 50100
+
+	// DefaultBroadcastFormat -.
+	DefaultBroadcastFormat = BroadcastOpus
+
+	// DefaultBroadcastBitrate -.
+	DefaultBroadcastBitrate = 128
+
+	// DefaultScrobbleService -.
+	DefaultScrobbleService = ScrobbleListenBrainz
+
+	// DefaultScrobbleRetryInterval -.
+	DefaultScrobbleRetryInterval = 300
+
+	// DefaultListenBrainzURL -.
+	DefaultListenBrainzURL = "https://api.listenbrainz.org"
+
+	// DefaultLastFMURL -.
+	DefaultLastFMURL = "https://ws.audioscrobbler.com/2.0/"
+
+	// DefaultScanningWorkers -.
+	DefaultScanningWorkers = 4
+)
+
+// Broadcast formats.
+const (
+	BroadcastOpus = "opus"
+	BroadcastMP3  = "mp3"
+)
+
+// Scrobbling services, or compatible ones.
+const (
+	ScrobbleListenBrainz = "listenbrainz"
+	ScrobbleLastFM       = "lastfm"
+)
+
+// Playback backends.
+const (
+	PlaybackBackendGStreamer = "gstreamer"
+	PlaybackBackendFake      = "fake" // plays nothing, along a simulated clock
+)
+
+// Output sinks, besides the GStreamer element names.
+const (
+	OutputSinkAuto = "auto"
+	OutputSinkFake = "fake"
+	OutputSinkFile = "file"
+)
+
+// ReplayGain modes.
+const (
+	ReplayGainOff   = "off"
+	ReplayGainTrack = "track"
+	ReplayGainAlbum = "album"
 )
 
 // Server server-related config.
@@ -34,8 +108,72 @@ type Server struct {
 	} `json:"database"`
 
 	Playback struct {
+		Backend string `json:"backend"` // gstreamer or fake
+
+		Crossfade struct {
+			Enabled  bool `json:"enabled"`
+			Duration int  `json:"duration"` // in seconds
+		} `json:"crossfade"`
+
+		ReplayGain struct {
+			Mode          string  `json:"mode"`   // off, track or album
+			Preamp        float64 `json:"preamp"` // in dB
+			AllowClipping bool    `json:"allowClipping"`
+		} `json:"replayGain"`
+
+		Rate struct {
+			// Perspectives maps a perspective name to its default rate.
+			Perspectives map[string]float64 `json:"perspectives"`
+		} `json:"rate"`
+
+		Resume struct {
+			// Perspectives lists the perspectives whose tracks resume
+			// playback from the last position.
+			Perspectives []string `json:"perspectives"`
+		} `json:"resume"`
+
+		// Output defines the default output, used until one is chosen
+		// through the API.
+		Output struct {
+			Sink   string `json:"sink"`   // auto, fake, file or a GStreamer sink (e.g., pulsesink)
+			Device string `json:"device"` // sink's device or, for the file sink, the output path
+		} `json:"output"`
+
+		// Progress defines how often the playback progress (i.e., position,
+		// duration and buffering) is pushed to subscribers.
+		Progress struct {
+			Interval int `json:"interval"` // in milliseconds
+		} `json:"progress"`
+
+		// Stream defines how the metadata reported by remote streams
+		// (e.g., internet radio) is handled.
+		Stream struct {
+			History      bool `json:"history"`      // keep a list of the titles heard in each station
+			HistoryLimit int  `json:"historyLimit"` // titles kept per station
+		} `json:"stream"`
 	} `json:"playback"`
 
+	Broadcast struct {
+		AutoStart bool   `json:"autoStart"`
+		Host      string `json:"host"` // empty to listen on all interfaces
+		Port      int    `json:"port"`
+		Format    string `json:"format"`  // opus or mp3
+		Bitrate   int    `json:"bitrate"` // in kbps
+	} `json:"broadcast"`
+
+	// Scrobble defines how played tracks are submitted to a scrobbling
+	// service. Plays are kept in an outbox until they are submitted.
+	Scrobble struct {
+		Enabled       bool   `json:"enabled"`
+		Service       string `json:"service"`       // listenbrainz or lastfm
+		URL           string `json:"url"`           // API root, to use a compatible service
+		Token         string `json:"token"`         // ListenBrainz user token
+		APIKey        string `json:"apiKey"`        // Last.fm API key
+		Secret        string `json:"secret"`        // Last.fm shared secret
+		SessionKey    string `json:"sessionKey"`    // Last.fm session key
+		RetryInterval int    `json:"retryInterval"` // in seconds
+	} `json:"scrobble"`
+
 	Query struct {
 		Limit int `json:"limit"`
 	} `json:"query"`
@@ -43,6 +181,8 @@ type Server struct {
 	Collection struct {
 		Scanning struct {
 			SkipCover bool `json:"skipCover"`
+			Hash      bool `json:"hash"`    // compare contents of files whose stats changed
+			Workers   int  `json:"workers"` // how many files are tagged at once
 		} `json:"scanning"`
 	} `json:"collection"`
 }
@@ -67,9 +207,84 @@ func (s *Server) SetDefaults() {
 
 	s.Database.Backup = true
 
+	if s.Playback.Backend == "" {
+		s.Playback.Backend = DefaultPlaybackBackend
+	}
+
+	if s.Playback.Crossfade.Duration <= 0 {
+		s.Playback.Crossfade.Duration = DefaultCrossfadeDuration
+	}
+
+	if s.Playback.Resume.Perspectives == nil {
+		s.Playback.Resume.Perspectives = []string{"audiobooks", "podcasts"}
+	}
+
+	if s.Playback.Output.Sink == "" {
+		s.Playback.Output.Sink = OutputSinkAuto
+	}
+
+	if s.Playback.Progress.Interval <= 0 {
+		s.Playback.Progress.Interval = DefaultProgressInterval
+	}
+
+	if s.Playback.Stream.HistoryLimit == 0 {
+		s.Playback.Stream.HistoryLimit = DefaultStreamHistoryLimit
+	}
+
+	switch s.Playback.ReplayGain.Mode {
+	case ReplayGainOff, ReplayGainTrack, ReplayGainAlbum:
+	default:
+		s.Playback.ReplayGain.Mode = DefaultReplayGainMode
+	}
+
+	if s.Broadcast.Port == 0 {
+		s.Broadcast.Port = DefaultBroadcastPort
+	}
+
+	switch s.Broadcast.Format {
+	case BroadcastOpus, BroadcastMP3:
+	default:
+		s.Broadcast.Format = DefaultBroadcastFormat
+	}
+
+	if s.Broadcast.Bitrate <= 0 {
+		s.Broadcast.Bitrate = DefaultBroadcastBitrate
+	}
+
+	switch s.Scrobble.Service {
+	case ScrobbleListenBrainz, ScrobbleLastFM:
+	default:
+		s.Scrobble.Service = DefaultScrobbleService
+	}
+
+	if s.Scrobble.URL == "" {
+		s.Scrobble.URL = DefaultListenBrainzURL
+		if s.Scrobble.Service == ScrobbleLastFM {
+			s.Scrobble.URL = DefaultLastFMURL
+		}
+	}
+
+	if s.Scrobble.RetryInterval <= 0 {
+		s.Scrobble.RetryInterval = DefaultScrobbleRetryInterval
+	}
+
 	if s.Query.Limit == 0 {
 		s.Query.Limit = DefaultQueryLimit
 	}
+
+	if s.Collection.Scanning.Workers <= 0 {
+		s.Collection.Scanning.Workers = DefaultScanningWorkers
+	}
+}
+
+// GetDefaultRate returns the default playback rate for the given perspective.
+func (s *Server) GetDefaultRate(perspective string) float64 {
+	for k, v := range s.Playback.Rate.Perspectives {
+		if strings.EqualFold(k, perspective) && v > 0 {
+			return v
+		}
+	}
+	return 1
 }
 
 // GetAuthority returns the authority portion of the playback URI.
@@ -0,0 +1,300 @@
+// Package cuesheet parses cue sheets, which describe the tracks contained
+// in a single audio file, such as an album ripped as a whole.
+package cuesheet
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// framesPerSecond defines the number of CD frames in one second.
+const framesPerSecond = 75
+
+// Sheet defines a parsed cue sheet.
+type Sheet struct {
+	Title      string
+	Performer  string
+	Songwriter string
+	Genre      string
+	Date       string
+	Discnumber int
+	Disctotal  int
+	Albumgain  float64 // ReplayGain, in dB
+	Albumpeak  float64
+	Files      []File
+}
+
+// File defines an audio file referenced by a cue sheet.
+type File struct {
+	Name   string
+	Type   string // WAVE, MP3, etc.
+	Tracks []Track
+}
+
+// Track defines a track in a cue sheet's file.
+type Track struct {
+	Number     int
+	Title      string
+	Performer  string
+	Songwriter string
+	ISRC       string
+	Trackgain  float64 // ReplayGain, in dB
+	Trackpeak  float64
+	Start      int64 // INDEX 01, in nanoseconds
+	End        int64 // start of the next track, or 0 if it is the last one
+}
+
+// Tracktotal returns the number of tracks in the sheet.
+func (s *Sheet) Tracktotal() (n int) {
+	for _, f := range s.Files {
+		n += len(f.Tracks)
+	}
+	return
+}
+
+// ParseFile parses the cue sheet at the given path.
+func ParseFile(path string) (*Sheet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Parse(f)
+}
+
+// Parse parses a cue sheet. Sheets that are not valid UTF-8 are read as
+// Latin-1, which is what most rippers use otherwise.
+func Parse(r io.Reader) (*Sheet, error) {
+	bv, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	bv = bytes.TrimPrefix(bv, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(bv) {
+		bv = latin1ToUTF8(bv)
+	}
+
+	s := &Sheet{}
+	var file *File
+	var track *Track
+
+	sc := bufio.NewScanner(bytes.NewReader(bv))
+	for n := 1; sc.Scan(); n++ {
+		fields := splitFields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		cmd, args := strings.ToUpper(fields[0]), fields[1:]
+		switch cmd {
+		case "FILE":
+			if len(args) < 1 {
+				return nil, fmt.Errorf("Missing file name at line %d", n)
+			}
+			s.Files = append(s.Files, File{Name: args[0]})
+			file = &s.Files[len(s.Files)-1]
+			if len(args) > 1 {
+				file.Type = strings.ToUpper(args[1])
+			}
+			track = nil
+		case "TRACK":
+			if file == nil {
+				return nil, fmt.Errorf("TRACK before FILE at line %d", n)
+			}
+			if len(args) < 1 {
+				return nil, fmt.Errorf("Missing track number at line %d", n)
+			}
+			number, err := strconv.Atoi(args[0])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid track number at line %d: %w", n, err)
+			}
+			file.Tracks = append(file.Tracks, Track{Number: number, Start: -1})
+			track = &file.Tracks[len(file.Tracks)-1]
+		case "INDEX":
+			if track == nil {
+				return nil, fmt.Errorf("INDEX before TRACK at line %d", n)
+			}
+			if len(args) < 2 {
+				return nil, fmt.Errorf("Incomplete INDEX at line %d", n)
+			}
+			if args[0] != "01" && args[0] != "1" {
+				continue
+			}
+			start, err := parseTime(args[1])
+			if err != nil {
+				return nil, fmt.Errorf("Invalid INDEX at line %d: %w", n, err)
+			}
+			track.Start = start
+		case "TITLE", "PERFORMER", "SONGWRITER", "ISRC":
+			if len(args) < 1 {
+				continue
+			}
+			if track != nil {
+				track.set(cmd, args[0])
+			} else {
+				s.set(cmd, args[0])
+			}
+		case "REM":
+			if len(args) < 2 {
+				continue
+			}
+			if track != nil {
+				track.setRemark(strings.ToUpper(args[0]), args[1])
+			} else {
+				s.setRemark(strings.ToUpper(args[0]), args[1])
+			}
+		default:
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range s.Files {
+		tracks := s.Files[i].Tracks
+		for j := range tracks {
+			if tracks[j].Start < 0 {
+				return nil, fmt.Errorf("Missing INDEX 01 for track %d", tracks[j].Number)
+			}
+			if j > 0 && tracks[j].Start < tracks[j-1].Start {
+				return nil, fmt.Errorf("Track %d starts before the previous one", tracks[j].Number)
+			}
+			if j+1 < len(tracks) {
+				tracks[j].End = tracks[j+1].Start
+			}
+		}
+	}
+
+	if s.Tracktotal() == 0 {
+		return nil, fmt.Errorf("No tracks found in cue sheet")
+	}
+	return s, nil
+}
+
+func (s *Sheet) set(cmd, value string) {
+	switch cmd {
+	case "TITLE":
+		s.Title = value
+	case "PERFORMER":
+		s.Performer = value
+	case "SONGWRITER":
+		s.Songwriter = value
+	default:
+	}
+}
+
+func (s *Sheet) setRemark(key, value string) {
+	switch key {
+	case "GENRE":
+		s.Genre = value
+	case "DATE":
+		s.Date = value
+	case "DISCNUMBER":
+		s.Discnumber, _ = strconv.Atoi(value)
+	case "TOTALDISCS":
+		s.Disctotal, _ = strconv.Atoi(value)
+	case "REPLAYGAIN_ALBUM_GAIN":
+		s.Albumgain = parseGain(value)
+	case "REPLAYGAIN_ALBUM_PEAK":
+		s.Albumpeak, _ = strconv.ParseFloat(value, 64)
+	default:
+	}
+}
+
+func (t *Track) set(cmd, value string) {
+	switch cmd {
+	case "TITLE":
+		t.Title = value
+	case "PERFORMER":
+		t.Performer = value
+	case "SONGWRITER":
+		t.Songwriter = value
+	case "ISRC":
+		t.ISRC = value
+	default:
+	}
+}
+
+func (t *Track) setRemark(key, value string) {
+	switch key {
+	case "REPLAYGAIN_TRACK_GAIN":
+		t.Trackgain = parseGain(value)
+	case "REPLAYGAIN_TRACK_PEAK":
+		t.Trackpeak, _ = strconv.ParseFloat(value, 64)
+	default:
+	}
+}
+
+// parseTime parses a cue sheet time, i.e., mm:ss:ff, where ff is in
+// frames.
+func parseTime(s string) (int64, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("Invalid time: %v", s)
+	}
+
+	var v [3]int64
+	for i := range parts {
+		n, err := strconv.ParseInt(parts[i], 10, 64)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("Invalid time: %v", s)
+		}
+		v[i] = n
+	}
+	if v[1] >= 60 || v[2] >= framesPerSecond {
+		return 0, fmt.Errorf("Invalid time: %v", s)
+	}
+
+	frames := (v[0]*60+v[1])*framesPerSecond + v[2]
+	return frames * 1e9 / framesPerSecond, nil
+}
+
+// parseGain parses a ReplayGain value, such as "-7.89 dB".
+func parseGain(s string) float64 {
+	g, _ := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, "dB")), 64)
+	return g
+}
+
+// splitFields splits a line into its fields, honoring double quotes. For
+// REM lines, everything after the key is kept as one field.
+func splitFields(line string) (fields []string) {
+	line = strings.TrimSpace(line)
+	for line != "" {
+		var field string
+		if line[0] == '"' {
+			end := strings.IndexByte(line[1:], '"')
+			if end < 0 {
+				field, line = line[1:], ""
+			} else {
+				field, line = line[1:end+1], line[end+2:]
+			}
+		} else if len(fields) == 2 && strings.EqualFold(fields[0], "REM") {
+			field, line = strings.Trim(line, `"`), ""
+		} else {
+			end := strings.IndexAny(line, " \t")
+			if end < 0 {
+				field, line = line, ""
+			} else {
+				field, line = line[:end], line[end:]
+			}
+		}
+		fields = append(fields, field)
+		line = strings.TrimSpace(line)
+	}
+	return
+}
+
+func latin1ToUTF8(bv []byte) []byte {
+	out := make([]rune, len(bv))
+	for i, b := range bv {
+		out[i] = rune(b)
+	}
+	return []byte(string(out))
+}
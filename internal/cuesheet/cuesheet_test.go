@@ -0,0 +1,91 @@
+package cuesheet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFile(t *testing.T) {
+	s, err := ParseFile("../../data/testing/cuesheet/album.cue")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Some Album", s.Title)
+	assert.Equal(t, "Some Band", s.Performer)
+	assert.Equal(t, "Progressive Rock", s.Genre)
+	assert.Equal(t, "1973", s.Date)
+	assert.Equal(t, -6.5, s.Albumgain)
+	assert.Equal(t, 3, s.Tracktotal())
+
+	require.Len(t, s.Files, 1)
+	f := s.Files[0]
+	assert.Equal(t, "album.wav", f.Name)
+	assert.Equal(t, "WAVE", f.Type)
+
+	require.Len(t, f.Tracks, 3)
+	assert.Equal(t, "First Song", f.Tracks[0].Title)
+	assert.Equal(t, int64(0), f.Tracks[0].Start)
+	assert.Equal(t, int64(252e9), f.Tracks[0].End)
+
+	assert.Equal(t, "Guest Singer", f.Tracks[1].Performer)
+	assert.Equal(t, -7.25, f.Tracks[1].Trackgain)
+	assert.Equal(t, int64(252e9), f.Tracks[1].Start)
+	assert.Equal(t, f.Tracks[2].Start, f.Tracks[1].End)
+
+	assert.Equal(t, "Café", f.Tracks[2].Title, "Latin-1 is read as such")
+	assert.Equal(t, int64(42787)*1e9/75, f.Tracks[2].Start)
+	assert.Equal(t, int64(0), f.Tracks[2].End)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		sheet string
+	}{
+		{"no tracks", "FILE \"a.flac\" WAVE\n"},
+		{"track before file", "TRACK 01 AUDIO\n  INDEX 01 00:00:00\n"},
+		{"missing index", "FILE \"a.flac\" WAVE\n  TRACK 01 AUDIO\n"},
+		{"invalid time", "FILE \"a.flac\" WAVE\n  TRACK 01 AUDIO\n    INDEX 01 00:61:00\n"},
+		{
+			"unordered tracks",
+			"FILE \"a.flac\" WAVE\n  TRACK 01 AUDIO\n    INDEX 01 02:00:00\n" +
+				"  TRACK 02 AUDIO\n    INDEX 01 01:00:00\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tc.sheet))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLocation(t *testing.T) {
+	const source = "file:///music/album%231.flac"
+
+	l := Location(source, 252e9, 570496e6)
+	assert.Equal(t, source+"#t=252.000,570.496", l)
+
+	s, start, end, ok := SplitLocation(l)
+	assert.True(t, ok)
+	assert.Equal(t, source, s)
+	assert.Equal(t, int64(252e9), start)
+	assert.Equal(t, int64(570496e6), end)
+
+	l = Location(source, 570496e6, 0)
+	assert.Equal(t, source+"#t=570.496", l)
+
+	_, start, end, ok = SplitLocation(l)
+	assert.True(t, ok)
+	assert.Equal(t, int64(570496e6), start)
+	assert.Equal(t, int64(0), end)
+
+	for _, l := range []string{source, "file:///music/a#t=x.flac", source + "#t=5,2"} {
+		s, _, _, ok := SplitLocation(l)
+		assert.False(t, ok, l)
+		assert.Equal(t, l, s)
+	}
+}
@@ -0,0 +1,62 @@
+package cuesheet
+
+import (
+	"strconv"
+	"strings"
+)
+
+// rangePrefix precedes the time range appended to the location of a
+// virtual track, following the Media Fragments syntax (i.e., #t=start,end,
+// in seconds).
+const rangePrefix = "#t="
+
+// Location returns the location of the virtual track that spans the
+// given range of the source. An end of zero stands for the end of the
+// source.
+func Location(source string, start, end int64) string {
+	s := source + rangePrefix + formatSeconds(start)
+	if end > 0 {
+		s += "," + formatSeconds(end)
+	}
+	return s
+}
+
+// SplitLocation returns the source and range of the given location, if it
+// belongs to a virtual track.
+func SplitLocation(location string) (source string, start, end int64, ok bool) {
+	i := strings.LastIndex(location, rangePrefix)
+	if i < 0 {
+		return location, 0, 0, false
+	}
+
+	startStr, endStr, hasEnd := strings.Cut(location[i+len(rangePrefix):], ",")
+	var err error
+	if start, err = parseSeconds(startStr); err != nil {
+		return location, 0, 0, false
+	}
+	if hasEnd {
+		if end, err = parseSeconds(endStr); err != nil || end <= start {
+			return location, 0, 0, false
+		}
+	}
+	return location[:i], start, end, true
+}
+
+// Source returns the location of the file that holds the given location,
+// which is the location itself unless it belongs to a virtual track.
+func Source(location string) string {
+	source, _, _, _ := SplitLocation(location)
+	return source
+}
+
+func formatSeconds(ns int64) string {
+	return strconv.FormatFloat(float64(ns)/1e9, 'f', 3, 64)
+}
+
+func parseSeconds(s string) (int64, error) {
+	sec, err := strconv.ParseFloat(s, 64)
+	if err != nil || sec < 0 {
+		return 0, strconv.ErrSyntax
+	}
+	return int64(sec*1e3+0.5) * 1e6, nil
+}
@@ -11,5 +11,18 @@ func All() []*gormigrate.Migration {
 		m20230515200631346_add_query_id_to_playlist(),
 		m20230515223654066_add_lastplayedfor_to_playlist_track(),
 		m20231218164345055_add_bucket_to_playlist(),
+		m20261018093512417_add_replaygain_to_track(),
+		m20261018141027905_add_playback_settings(),
+		m20261018170244318_add_repeat_to_playbar(),
+		m20261018183355142_add_shuffle_to_playbar(),
+		m20261018201544730_add_resume_position(),
+		m20261018213007261_add_equalizer_preset(),
+		m20261018224130518_add_output_to_playback_settings(),
+		m20261018233605114_add_station_history(),
+		m20261018235012466_add_playback_failure(),
+		m20261018235540118_add_cuesheet_to_track(),
+		m20261018235817402_add_scrobble(),
+		m20261019001204733_add_file_stats_to_track(),
+		m20261019003518296_add_watched_to_collection(),
 	}
 }
@@ -14,10 +14,16 @@ func InitSchema(db *gorm.DB) (err error) {
 		&models.Collection{},
 		&models.Query{},
 		&models.Perspective{},
+		&models.PlaybackSettings{},
+		&models.EqualizerPreset{},
 
 		// soft reference
 		&models.Playback{},
 		&models.PlaybackHistory{},
+		&models.ResumePosition{},
+		&models.StationHistory{},
+		&models.PlaybackFailure{},
+		&models.Scrobble{},
 
 		// one foreign key
 		&models.Track{},
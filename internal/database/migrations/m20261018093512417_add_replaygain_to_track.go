@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+func m20261018093512417_add_replaygain_to_track() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018093512417",
+
+		Migrate: func(tx *gorm.DB) error {
+			for _, col := range []string{"Trackgain", "Trackpeak", "Albumgain", "Albumpeak"} {
+				if tx.Migrator().HasColumn(&models.Track{}, col) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&models.Track{}, col); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			for _, col := range []string{"trackgain", "trackpeak", "albumgain", "albumpeak"} {
+				if err := tx.Migrator().DropColumn("track", col); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
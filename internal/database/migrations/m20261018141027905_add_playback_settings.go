@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+// playbackSettings20261018141027905 defines the playback_settings table as
+// introduced by this migration, since later ones add columns to it.
+type playbackSettings20261018141027905 struct {
+	models.Model
+	Volume float64 `json:"volume" gorm:"not null"`
+	Mute   bool    `json:"mute"`
+}
+
+func (playbackSettings20261018141027905) TableName() string {
+	return "playback_settings"
+}
+
+func m20261018141027905_add_playback_settings() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018141027905",
+
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&playbackSettings20261018141027905{})
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("playback_settings")
+		},
+	}
+}
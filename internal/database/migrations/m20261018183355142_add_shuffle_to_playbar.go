@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+func m20261018183355142_add_shuffle_to_playbar() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018183355142",
+
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&models.Playbar{}, "Shuffle") {
+				return nil
+			}
+			return tx.Migrator().AddColumn(&models.Playbar{}, "Shuffle")
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn("playbar", "shuffle")
+		},
+	}
+}
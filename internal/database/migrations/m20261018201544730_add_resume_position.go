@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+// resumePosition20261018201544730 defines the resume_position table as
+// introduced by this migration.
+type resumePosition20261018201544730 struct {
+	models.Model
+	Position int64 `json:"position"`
+	Duration int64 `json:"duration"`
+	TrackID  int64 `json:"trackId" gorm:"uniqueIndex:unique_idx_resume_position_track_id,not null"`
+}
+
+func (resumePosition20261018201544730) TableName() string {
+	return "resume_position"
+}
+
+func m20261018201544730_add_resume_position() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018201544730",
+
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&resumePosition20261018201544730{})
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("resume_position")
+		},
+	}
+}
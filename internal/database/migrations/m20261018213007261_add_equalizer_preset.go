@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/seeds"
+	"gorm.io/gorm"
+)
+
+// equalizerPreset20261018213007261 defines the equalizer_preset table as
+// introduced by this migration, with the bands stored as JSON text.
+type equalizerPreset20261018213007261 struct {
+	models.Model
+	Name        string `json:"name" gorm:"uniqueIndex:unique_idx_equalizer_preset_name,not null"`
+	Description string `json:"description"`
+	Bands       string `json:"bands"`
+	Genre       string `json:"genre" gorm:"index:idx_equalizer_preset_genre"`
+}
+
+func (equalizerPreset20261018213007261) TableName() string {
+	return "equalizer_preset"
+}
+
+func m20261018213007261_add_equalizer_preset() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018213007261",
+
+		Migrate: func(tx *gorm.DB) error {
+			if err := tx.Migrator().CreateTable(&equalizerPreset20261018213007261{}); err != nil {
+				return err
+			}
+
+			for _, m := range []any{&models.PlaybackSettings{}, &models.Playbar{}} {
+				if tx.Migrator().HasColumn(m, "EqualizerPresetID") {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(m, "EqualizerPresetID"); err != nil {
+					return err
+				}
+			}
+
+			return seeds.SeedEqualizerPreset(tx)
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn("playbar", "equalizer_preset_id"); err != nil {
+				return err
+			}
+			if err := tx.Migrator().DropColumn("playback_settings", "equalizer_preset_id"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropTable("equalizer_preset")
+		},
+	}
+}
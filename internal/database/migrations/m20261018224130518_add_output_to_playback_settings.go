@@ -0,0 +1,32 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+func m20261018224130518_add_output_to_playback_settings() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018224130518",
+
+		Migrate: func(tx *gorm.DB) error {
+			for _, name := range []string{"OutputSink", "OutputDevice"} {
+				if tx.Migrator().HasColumn(&models.PlaybackSettings{}, name) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&models.PlaybackSettings{}, name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropColumn("playback_settings", "output_device"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn("playback_settings", "output_sink")
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+// stationHistory20261018233605114 defines the station_history table at the
+// time of this migration.
+type stationHistory20261018233605114 struct {
+	models.Model
+	Location     string `json:"location" gorm:"index:idx_station_history_location,not null"`
+	Title        string `json:"title"`
+	Artist       string `json:"artist"`
+	Organization string `json:"organization"`
+}
+
+func (stationHistory20261018233605114) TableName() string {
+	return "station_history"
+}
+
+func m20261018233605114_add_station_history() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018233605114",
+
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&stationHistory20261018233605114{})
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("station_history")
+		},
+	}
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+// playbackFailure20261018235012466 defines the playback_failure table as
+// first created, independently of the model.
+type playbackFailure20261018235012466 struct {
+	models.Model
+	Location string `json:"location" gorm:"uniqueIndex:unique_idx_playback_failure_location,not null"`
+	TrackID  int64  `json:"trackId"`
+	Reason   int    `json:"reason"`
+	Message  string `json:"message"`
+	Attempts int    `json:"attempts"`
+}
+
+func (playbackFailure20261018235012466) TableName() string {
+	return "playback_failure"
+}
+
+func m20261018235012466_add_playback_failure() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018235012466",
+
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&playbackFailure20261018235012466{})
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("playback_failure")
+		},
+	}
+}
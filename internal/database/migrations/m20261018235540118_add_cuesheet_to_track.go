@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+func m20261018235540118_add_cuesheet_to_track() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018235540118",
+
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&models.Track{}, "Cuesheet") {
+				return nil
+			}
+			if err := tx.Migrator().AddColumn(&models.Track{}, "Cuesheet"); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateIndex(&models.Track{}, "idx_track_cuesheet")
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex("track", "idx_track_cuesheet"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn("track", "cuesheet")
+		},
+	}
+}
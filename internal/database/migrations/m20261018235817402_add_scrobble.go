@@ -0,0 +1,42 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+// scrobble20261018235817402 defines the scrobble table as introduced by this
+// migration.
+type scrobble20261018235817402 struct {
+	models.Model
+	TrackID     int64  `json:"trackId" gorm:"index:idx_scrobble_track_id"`
+	Artist      string `json:"artist"`
+	Title       string `json:"title"`
+	Album       string `json:"album"`
+	Albumartist string `json:"albumartist"`
+	Tracknumber int    `json:"tracknumber"`
+	Duration    int64  `json:"duration"`
+	PlayedAt    int64  `json:"playedAt" gorm:"index:idx_scrobble_played_at"`
+	Submitted   int64  `json:"submitted" gorm:"index:idx_scrobble_submitted"`
+	Attempts    int    `json:"attempts"`
+	LastError   string `json:"lastError"`
+}
+
+func (scrobble20261018235817402) TableName() string {
+	return "scrobble"
+}
+
+func m20261018235817402_add_scrobble() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261018235817402",
+
+		Migrate: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&scrobble20261018235817402{})
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable("scrobble")
+		},
+	}
+}
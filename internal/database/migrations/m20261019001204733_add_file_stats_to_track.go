@@ -0,0 +1,34 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+func m20261019001204733_add_file_stats_to_track() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261019001204733",
+
+		Migrate: func(tx *gorm.DB) error {
+			for _, name := range []string{"Filesize", "Filemtime", "Filehash"} {
+				if tx.Migrator().HasColumn(&models.Track{}, name) {
+					continue
+				}
+				if err := tx.Migrator().AddColumn(&models.Track{}, name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			for _, name := range []string{"filesize", "filemtime", "filehash"} {
+				if err := tx.Migrator().DropColumn("track", name); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
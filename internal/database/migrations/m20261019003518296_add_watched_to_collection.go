@@ -0,0 +1,24 @@
+package migrations
+
+import (
+	"github.com/go-gormigrate/gormigrate/v2"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+func m20261019003518296_add_watched_to_collection() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261019003518296",
+
+		Migrate: func(tx *gorm.DB) error {
+			if tx.Migrator().HasColumn(&models.Collection{}, "Watched") {
+				return nil
+			}
+			return tx.Migrator().AddColumn(&models.Collection{}, "Watched")
+		},
+
+		Rollback: func(tx *gorm.DB) error {
+			return tx.Migrator().DropColumn("collection", "watched")
+		},
+	}
+}
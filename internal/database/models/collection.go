@@ -6,7 +6,10 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/jwmwalrus/bnp/onerror"
 	"github.com/jwmwalrus/bnp/pointers"
@@ -14,6 +17,7 @@ import (
 	"github.com/jwmwalrus/gear-pieces/idler"
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
 	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
 	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
 	rtc "github.com/jwmwalrus/rtcycler"
 	"google.golang.org/protobuf/proto"
@@ -57,6 +61,7 @@ const (
 	CollectionEventItemRemoved
 	CollectionEventScanning
 	CollectionEventScanningDone
+	CollectionEventScanningProgress
 )
 
 func (ce CollectionEvent) String() string {
@@ -70,6 +75,7 @@ func (ce CollectionEvent) String() string {
 		"item-removed",
 		"scanning",
 		"scanning-done",
+		"scanning-progress",
 	}[ce]
 }
 
@@ -84,6 +90,7 @@ type Collection struct {
 	Hidden         bool        `json:"hidden"`
 	Disabled       bool        `json:"disabled"`
 	Remote         bool        `json:"remote"`
+	Watched        bool        `json:"watched"` // if changes to its files are applied as they happen
 	Scanned        int         `json:"scanned"`
 	Tracks         int64       `json:"tracks" gorm:"-"`
 	PerspectiveID  int64       `json:"perspectiveId" gorm:"index:idx_collection_perspective_id,not null"`
@@ -181,6 +188,7 @@ func (c *Collection) ToProtobuf() proto.Message {
 		Scanned:        int32(c.Scanned),
 		Tracks:         c.Tracks,
 		Perspective:    m3uetcpb.Perspective(c.Perspective.Idx),
+		Watched:        c.Watched,
 		CreatedAt:      timestamppb.New(time.Unix(0, c.CreatedAt)),
 		UpdatedAt:      timestamppb.New(time.Unix(0, c.UpdatedAt)),
 	}
@@ -256,7 +264,11 @@ func (c *Collection) CountTracks() {
 	c.Tracks = tracks
 }
 
-// Scan adds tracks to collection.
+// Scan adds tracks to collection, and removes those whose files do not
+// exist anymore. Tags are read only for new or modified files, through a
+// pool of workers. If withTags is true, files whose size or modification
+// time changed are re-tagged even if their content hash did not. The scan can be controlled
+// with ControlScan, and its progress is broadcast along the way.
 func (c *Collection) Scan(withTags bool) {
 	logw := slog.With(
 		"c", *c,
@@ -268,18 +280,35 @@ func (c *Collection) Scan(withTags bool) {
 		return
 	}
 
+	// registered before waiting for its turn, so it can be canceled
+	st, ok := registerScan(c.ID)
+	if !ok {
+		logw.Info("Collection is already being scanned")
+		return
+	}
+	defer unregisterScan(c.ID)
+
 	logw.Info("Scanning collection")
 
 	storageGuard <- struct{}{}
 	defer func() { <-storageGuard }()
 
+	s := newCollectionScanner(c, st, logw)
+
 	subscription.Broadcast(
 		subscription.ToCollectionStoreEvent,
-		subscription.Event{Idx: int(CollectionEventScanning)})
+		subscription.Event{
+			Idx:  int(CollectionEventScanning),
+			Data: &ScanProgress{CollectionID: c.ID},
+		})
 	defer func() {
+		p := s.progress
 		subscription.Broadcast(
 			subscription.ToCollectionStoreEvent,
-			subscription.Event{Idx: int(CollectionEventScanningDone)})
+			subscription.Event{
+				Idx:  int(CollectionEventScanningDone),
+				Data: &p,
+			})
 	}()
 
 	rootDir, err := urlstr.URLToPath(c.Location)
@@ -296,70 +325,170 @@ func (c *Collection) Scan(withTags bool) {
 	idler.GetBusy(idler.StatusDbOperations)
 	defer func() { idler.GetFree(idler.StatusDbOperations) }()
 
-	var iTrack, nTrack, unsupp, scanErr int
-	err = filepath.Walk(rootDir, func(path string, i os.FileInfo, err error) error {
+	// files described by a cue sheet are indexed through their virtual
+	// tracks, and not as a whole, so sheets are parsed before indexing
+	files := []scanFile{}
+	sheets := map[string]*cuesheet.Sheet{}
+	cued := map[string]bool{}
+
+	walkErr := filepath.Walk(rootDir, func(path string, i os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
 
+		if st.isCanceled() {
+			s.progress.Canceled = true
+			return filepath.SkipAll
+		}
+
 		if i.IsDir() {
 			return nil
 		}
-		nTrack++
+		files = append(files, scanFile{path, i})
+
+		if base.IsCueSheet(path) {
+			sheet, err := cuesheet.ParseFile(path)
+			if err != nil {
+				logw.Warn("Failed to parse cue sheet", "path", path, "error", err)
+				return nil
+			}
+			sheets[path] = sheet
+			for source := range cueSheetSources(path, sheet) {
+				cued[source] = true
+			}
+		}
 		return nil
 	})
 
 	onerrorw := onerror.NewRecorder(logw)
-	onerrorw.Warn(err)
+	onerrorw.Warn(walkErr)
 
-	if nTrack == 0 {
+	ts := []Track{}
+	if err = db.Where("collection_id = ?", c.ID).Find(&ts).Error; err != nil {
+		logw.Error("Failed to find collection tracks", "error", err)
 		return
 	}
+	known := map[string]*Track{}
+	for i := range ts {
+		known[ts[i].Location] = &ts[i]
+	}
 
-	tx := db.Session(&gorm.Session{SkipHooks: true})
-	err = filepath.Walk(rootDir, func(path string, i os.FileInfo, err error) error {
-		if err != nil {
-			return err
+	seen := map[string]bool{}
+	nTrack := len(files)
+	var iTrack, unsupp int
+
+	s.start(nTrack)
+	for _, f := range files {
+		if !s.wait() {
+			break
 		}
 
-		if i.IsDir() {
-			return nil
+		iTrack++
+		if iTrack%100 == 0 {
+			c.Scanned = int((float32(iTrack) / float32(nTrack)) * 100)
+			onerrorw.Log(c.Save())
 		}
 
-		if !base.IsSupportedFile(path) {
-			if !base.IsIgnoredFile(path) {
+		s.progress.Seen++
+		s.progress.CurrentPath = f.path
+		s.report(false)
+
+		if base.IsCueSheet(f.path) {
+			sheet, ok := sheets[f.path]
+			if !ok {
+				s.progress.Failed++
+				continue
+			}
+			locations, tagged, err := c.addTracksFromCueSheet(s.tx, f.path,
+				f.info, sheet, known)
+			isNew := false
+			for _, l := range locations {
+				seen[l] = true
+				if _, ok := known[l]; !ok {
+					isNew = true
+				}
+			}
+			switch {
+			case err != nil:
+				logw.Warn("Failed to add tracks from cue sheet", "path", f.path, "error", err)
+				s.progress.Failed++
+			case isNew:
+				s.progress.Added++
+			case tagged > 0:
+				s.progress.Updated++
+			default:
+				s.progress.Unchanged++
+			}
+			continue
+		}
+
+		if cued[f.path] {
+			continue
+		}
+
+		if !base.IsSupportedFile(f.path) {
+			if !base.IsIgnoredFile(f.path) {
 				logw.With(
-					"path", path,
-					"extension", filepath.Ext(path),
+					"path", f.path,
+					"extension", filepath.Ext(f.path),
 				).
 					Info("Unsupported file")
 
 				unsupp++
 			}
-			return nil
+			continue
 		}
 
-		iTrack++
+		location, err := urlstr.PathToURL(f.path)
+		if err != nil {
+			logw.Warn("Failed to convert path to URL", "path", f.path, "error", err)
+			s.progress.Failed++
+			continue
+		}
+		seen[location] = true
 
-		if _, err = c.addTrackFromPath(tx, path, withTags); err != nil {
-			logw.Warn("Failed to add track from path", "error", err)
-			scanErr++
-			return nil
+		fs := newFileStat(f.info)
+		t, ok := known[location]
+		if ok && !t.isModified("", &fs) {
+			if t.setFileStat(fs) {
+				onerrorw.Log(t.SaveTx(s.tx))
+			}
+			s.progress.Unchanged++
+			continue
 		}
 
-		if iTrack%100 == 0 {
-			c.Scanned = int((float32(iTrack) / float32(nTrack)) * 100)
-			onerrorw.Log(c.Save())
+		if !ok {
+			if t, err = c.trackForLocation(s.tx, location); err != nil {
+				logw.Warn("Failed to add track from path", "path", f.path, "error", err)
+				s.progress.Failed++
+				continue
+			}
 		}
 
-		return nil
-	})
-	onerrorw.Warn(err)
+		s.dispatch(&scanJob{
+			t:         t,
+			path:      f.path,
+			fs:        fs,
+			checkHash: ok && !withTags,
+		})
+	}
+	s.stop()
+
+	// tracks cannot be told missing unless the whole tree was walked
+	if walkErr == nil && !s.progress.Canceled {
+		s.progress.Removed = c.removeUnseen(seen)
+	}
+
 	logw = logw.With(
 		"tracks-expected", nTrack,
 		"tracks-found", iTrack,
+		"added-tracks", s.progress.Added,
+		"updated-tracks", s.progress.Updated,
+		"unchanged-tracks", s.progress.Unchanged,
+		"removed-tracks", s.progress.Removed,
 		"unsupported-tracks", unsupp,
-		"scanning-errors-count", scanErr,
+		"scanning-errors-count", s.progress.Failed,
+		"canceled", s.progress.Canceled,
 	)
 	logw.Info("ScanCollection Summary")
 
@@ -367,86 +496,530 @@ func (c *Collection) Scan(withTags bool) {
 	onerror.NewRecorder(logw).Log(c.Save())
 }
 
-// Verify removes tracks that do not exist in the collection anymore.
-func (c *Collection) Verify() {
-	logw := slog.With("c", *c)
-	logw.Info("Verifying collection")
-
-	if c.Disabled {
-		logw.Info("Cannot verify collection while disabled")
-		return
-	}
+// scanFile defines a file found while scanning a collection.
+type scanFile struct {
+	path string
+	info os.FileInfo
+}
 
+// removeUnseen removes the tracks of the collection whose locations were
+// not seen while scanning, and returns how many were removed.
+func (c *Collection) removeUnseen(seen map[string]bool) (n int) {
 	s := []Track{}
 	if err := db.Where("collection_id = ?", c.ID).Find(&s).Error; err != nil {
+		slog.Error("Failed to find collection tracks", "error", err)
 		return
 	}
 
 	for i := range s {
-		if urlstr.URLExists(s[i].Location) {
+		if seen[s[i].Location] {
 			continue
 		}
-
-		DeleteDanglingTrack(&s[i], c, true)
+		if err := DeleteDanglingTrack(&s[i], c, true); err != nil {
+			slog.Warn("Failed to remove dangling track", "location", s[i].Location, "error", err)
+			continue
+		}
+		n++
 	}
+	return
 }
 
+// addTrackFromLocation adds or updates the track at the given location,
+// reading its tags.
 func (c *Collection) addTrackFromLocation(tx *gorm.DB, location string,
-	withTags bool) (t *Track, err error) {
+	fs fileStat) (t *Track, err error) {
+
+	if t, err = c.trackForLocation(tx, location); err != nil {
+		return
+	}
+
+	if err2 := t.updateTags(); err2 != nil {
+		slog.Warn("Failed to update tags", "location", location, "error", err2)
+	}
+	t.setFileStat(fs)
 
+	err = t.SaveTx(tx)
+	return
+}
+
+// trackForLocation returns the track to be added to the collection for the
+// given location, i.e., a new one, or an existing one that belongs to this
+// collection or to the transient one.
+func (c *Collection) trackForLocation(tx *gorm.DB, location string) (t *Track, err error) {
 	logw := slog.With("location", location)
 
-	doTag := false
-	newt := &Track{}
-	err2 := tx.Where("location = ?", location).First(newt).Error
-	if err2 != nil {
-		newt = &Track{
+	t = &Track{}
+	if tx.Where("location = ?", location).First(t).Error != nil {
+		t = &Track{
 			Location:     location,
 			CollectionID: c.ID,
 		}
-		doTag = true
+		return
+	}
+
+	trColl, err := TransientCollection.Get()
+	if err != nil {
+		return
+	}
+
+	if t.CollectionID != trColl.ID {
+		if t.CollectionID != c.ID {
+			err = fmt.Errorf("Track already belongs to another collection")
+			return
+		}
+		logw.Info("Track already in a collection", "collection", c.Name)
 	} else {
-		trColl, err2 := TransientCollection.Get()
-		if err2 != nil {
-			err = err2
+		logw.Info("Reusing transient track")
+		t.CollectionID = c.ID
+	}
+	return
+}
+
+// addTracksFromCueSheet adds the virtual tracks described by the given cue
+// sheet, replacing the whole-file tracks of their sources, and returns
+// their locations, along with how many tracks were tagged. Existing
+// virtual tracks are updated only if the sheet or their source changed.
+func (c *Collection) addTracksFromCueSheet(tx *gorm.DB, path string,
+	info os.FileInfo, sheet *cuesheet.Sheet,
+	known map[string]*Track) (locations []string, tagged int, err error) {
+
+	logw := slog.With("path", path)
+
+	cueLocation, err := urlstr.PathToURL(path)
+	if err != nil {
+		return
+	}
+
+	for sourcePath, f := range cueSheetSources(path, sheet) {
+		var source string
+		if source, err = urlstr.PathToURL(sourcePath); err != nil {
+			return
+		}
+
+		var si os.FileInfo
+		if si, err = os.Stat(sourcePath); err != nil {
 			return
 		}
 
-		if newt.CollectionID != trColl.ID {
-			if newt.CollectionID != c.ID {
-				err = fmt.Errorf("Track already belongs to another collection")
+		// virtual tracks change with either the source or the sheet
+		fs := newFileStat(si)
+		fs.mtime = max(fs.mtime, info.ModTime().UnixNano())
+
+		// tags of the whole source, read only if needed
+		var st *Track
+		for i := range f.Tracks {
+			ct := &f.Tracks[i]
+			location := cuesheet.Location(source, ct.Start, ct.End)
+			locations = append(locations, location)
+
+			t, ok := known[location]
+			if ok && !t.isModified("", &fs) {
+				if t.setFileStat(fs) {
+					if err = t.SaveTx(tx); err != nil {
+						return
+					}
+				}
+				continue
+			}
+			if !ok {
+				t = &Track{}
+				err2 := tx.Where("location = ?", location).First(t).Error
+				if err2 == nil && t.CollectionID != c.ID {
+					logw.Warn("Virtual track already belongs to another collection", "location", location)
+					continue
+				}
+			}
+
+			if st == nil {
+				var err2 error
+				st, err2 = ReadTagsForLocation(source)
+				if err2 != nil {
+					logw.Warn("Failed to read tags from cue sheet's source", "source", source, "error", err2)
+				}
+			}
+
+			t.Location = location
+			t.CollectionID = c.ID
+			t.Cuesheet = cueLocation
+			t.fillFromCueSheet(st, sheet, ct)
+			t.setFileStat(fs)
+			if err = t.SaveTx(tx); err != nil {
 				return
 			}
-			logw.Info("Track already in a collection", "collection", c.Name)
-		} else {
-			logw.Info("Reusing transient track")
-			newt.CollectionID = c.ID
+			tagged++
+		}
+
+		wt := &Track{}
+		if tx.Where("location = ?", source).First(wt).Error == nil {
+			logw.Info("Replacing whole-file track with virtual ones", "source", source)
+			onerror.Warn(DeleteDanglingTrack(wt, c, true))
 		}
 	}
+	return
+}
+
+// SyncPath applies the current state of the file or directory at the given
+// path, under the collection's location, to the collection's tracks, i.e.,
+// it adds, updates or removes the corresponding tracks.
+func (c *Collection) SyncPath(path string) error {
+	storageGuard <- struct{}{}
+	defer func() { <-storageGuard }()
 
-	t = newt
+	ps := newPathSyncer(c)
+	defer ps.broadcast()
+
+	i, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return ps.removePath(path)
+	} else if err != nil {
+		return err
+	}
+
+	if !i.IsDir() {
+		return ps.syncFile(path, i)
+	}
+
+	return filepath.Walk(path, func(path string, i os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if i.IsDir() {
+			return nil
+		}
 
-	if withTags || doTag {
-		err2 := t.updateTags()
-		if err2 != nil {
-			logw.Warn("Failed to update tags", "error", err2)
+		if err := ps.syncFile(path, i); err != nil {
+			slog.Warn("Failed to sync file", "path", path, "error", err)
 		}
+		return nil
+	})
+}
+
+// MovePath moves the tracks of the file or directory at the given path to
+// the new one, keeping their IDs.
+func (c *Collection) MovePath(from, to string) error {
+	storageGuard <- struct{}{}
+	defer func() { <-storageGuard }()
+
+	ps := newPathSyncer(c)
+	defer ps.broadcast()
+
+	fromLocation, err := urlstr.PathToURLUnchecked(from)
+	if err != nil {
+		return err
+	}
+	toLocation, err := urlstr.PathToURLUnchecked(to)
+	if err != nil {
+		return err
 	}
 
-	err = t.SaveTx(tx)
-	return
+	s, err := c.tracksUnder(fromLocation)
+	if err != nil {
+		return err
+	}
+
+	for i := range s {
+		t := &s[i]
+		t.Location, _ = movedLocation(t.Location, fromLocation, toLocation)
+		t.Cuesheet, _ = movedLocation(t.Cuesheet, fromLocation, toLocation)
+
+		// a file moved over another one replaces it
+		old := &Track{}
+		if ps.tx.Where("location = ? AND id <> ?", t.Location, t.ID).First(old).Error == nil {
+			onerror.Warn(ps.deleteTrack(old))
+		}
+
+		if err = t.SaveTx(ps.tx); err != nil {
+			return err
+		}
+		ps.add(CollectionEventItemChanged, t)
+	}
+	return nil
 }
 
-func (c *Collection) addTrackFromPath(tx *gorm.DB, path string,
-	withTags bool) (t *Track, err error) {
+// pathSyncer applies the changes of the files under a collection to its
+// tracks, skipping hooks, and collects the corresponding item events, so
+// that they are broadcast once the changes are done.
+type pathSyncer struct {
+	c      *Collection
+	tx     *gorm.DB
+	events []subscription.Event
+}
 
-	var u string
-	if u, err = urlstr.PathToURL(path); err != nil {
+func newPathSyncer(c *Collection) *pathSyncer {
+	return &pathSyncer{
+		c:  c,
+		tx: db.Session(&gorm.Session{SkipHooks: true}),
+	}
+}
+
+// add records an item event for the given track.
+func (ps *pathSyncer) add(ce CollectionEvent, t *Track) {
+	ps.events = append(ps.events, subscription.Event{
+		Idx:  int(ce),
+		Data: t,
+	})
+}
+
+// broadcast sends the item events recorded so far.
+func (ps *pathSyncer) broadcast() {
+	if len(ps.events) == 0 {
 		return
 	}
 
-	t, err = c.addTrackFromLocation(tx, u, withTags)
-	return
+	subscription.Broadcast(subscription.ToCollectionStoreEvent, ps.events...)
+	ps.events = nil
+}
+
+// deleteTrack deletes the given track, recording its removal.
+func (ps *pathSyncer) deleteTrack(t *Track) error {
+	if err := deleteDanglingTrack(ps.tx, t, ps.c, true); err != nil {
+		return err
+	}
+	ps.add(CollectionEventItemRemoved, t)
+	return nil
+}
+
+// syncFile adds or updates the tracks of the given file, if it changed.
+func (ps *pathSyncer) syncFile(path string, info os.FileInfo) error {
+	if base.IsCueSheet(path) {
+		return ps.syncCueSheet(path, info)
+	}
+
+	if !base.IsSupportedFile(path) {
+		return nil
+	}
+
+	// a file described by a cue sheet is indexed through its virtual tracks
+	if cue := findCueSheetFor(path); cue != "" {
+		ci, err := os.Stat(cue)
+		if err != nil {
+			return err
+		}
+		return ps.syncCueSheet(cue, ci)
+	}
+
+	location, err := urlstr.PathToURL(path)
+	if err != nil {
+		return err
+	}
+
+	fs := newFileStat(info)
+	t := &Track{}
+	err = ps.tx.Where("location = ?", location).First(t).Error
+	found := err == nil
+	if found && t.CollectionID == ps.c.ID && !t.isModified(path, &fs) {
+		if t.setFileStat(fs) {
+			if err = t.SaveTx(ps.tx); err != nil {
+				return err
+			}
+			ps.add(CollectionEventItemChanged, t)
+		}
+		return nil
+	}
+
+	fs.fillHash(path)
+	if t, err = ps.c.addTrackFromLocation(ps.tx, location, fs); err != nil {
+		return err
+	}
+
+	if found {
+		ps.add(CollectionEventItemChanged, t)
+	} else {
+		ps.add(CollectionEventItemAdded, t)
+	}
+	return nil
+}
+
+// syncCueSheet adds or updates the virtual tracks of the given cue sheet,
+// and removes those that it does not describe anymore.
+func (ps *pathSyncer) syncCueSheet(path string, info os.FileInfo) error {
+	sheet, err := cuesheet.ParseFile(path)
+	if err != nil {
+		return err
+	}
+
+	cueLocation, err := urlstr.PathToURL(path)
+	if err != nil {
+		return err
+	}
+
+	s := []Track{}
+	err = ps.tx.Where("collection_id = ? AND cuesheet = ?", ps.c.ID, cueLocation).
+		Find(&s).
+		Error
+	if err != nil {
+		return err
+	}
+	known := map[string]*Track{}
+	for i := range s {
+		known[s[i].Location] = &s[i]
+	}
+
+	locations, _, err := ps.c.addTracksFromCueSheet(ps.tx, path, info, sheet, known)
+	if err != nil {
+		return err
+	}
+
+	for _, location := range locations {
+		if t, ok := known[location]; ok {
+			ps.add(CollectionEventItemChanged, t)
+			continue
+		}
+
+		t := &Track{}
+		err := ps.tx.Where("collection_id = ? AND location = ?", ps.c.ID, location).
+			First(t).
+			Error
+		if err == nil {
+			ps.add(CollectionEventItemAdded, t)
+		}
+	}
+
+	for i := range s {
+		if !slices.Contains(locations, s[i].Location) {
+			onerror.Warn(ps.deleteTrack(&s[i]))
+		}
+	}
+	return nil
+}
+
+// removePath removes the tracks of the given file or directory. If the file
+// is a cue sheet, its sources are indexed as a whole again.
+func (ps *pathSyncer) removePath(path string) error {
+	location, err := urlstr.PathToURLUnchecked(path)
+	if err != nil {
+		return err
+	}
+
+	s, err := ps.c.tracksUnder(location)
+	if err != nil {
+		return err
+	}
+
+	sources := map[string]bool{}
+	for i := range s {
+		if s[i].Cuesheet == location {
+			sources[cuesheet.Source(s[i].Location)] = true
+		}
+		onerror.Warn(ps.deleteTrack(&s[i]))
+	}
+
+	for source := range sources {
+		sourcePath, err := urlstr.URLToPath(source)
+		if err != nil {
+			continue
+		}
+		if i, err := os.Stat(sourcePath); err == nil {
+			onerror.Warn(ps.syncFile(sourcePath, i))
+		}
+	}
+	return nil
+}
+
+// tracksUnder returns the collection's tracks that belong to the given
+// location, i.e., its own track, its virtual tracks, those of the cue sheet
+// at the location, or those under it, if it is a directory.
+func (c *Collection) tracksUnder(location string) ([]Track, error) {
+	s := []Track{}
+	n := utf8.RuneCountInString(location)
+	err := db.Where("collection_id = ?", c.ID).
+		Where("substr(location, 1, ?) = ? OR substr(cuesheet, 1, ?) = ?",
+			n, location, n, location).
+		Find(&s).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(s, func(t Track) bool {
+		_, okLocation := movedLocation(t.Location, location, location)
+		_, okCuesheet := movedLocation(t.Cuesheet, location, location)
+		return !okLocation && !okCuesheet
+	}), nil
+}
+
+// movedLocation returns the given location after moving the file or
+// directory at from to to, and true if the location belongs to it.
+func movedLocation(location, from, to string) (string, bool) {
+	rest, ok := strings.CutPrefix(location, from)
+	if !ok || rest != "" && rest[0] != '/' && cuesheet.Source(location) != from {
+		return location, false
+	}
+	return to + rest, true
+}
+
+// findCueSheetFor returns the path of the cue sheet that describes the
+// given file, if any.
+func findCueSheetFor(path string) string {
+	dir := filepath.Dir(path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+
+	for _, e := range entries {
+		cue := filepath.Join(dir, e.Name())
+		if e.IsDir() || !base.IsCueSheet(cue) {
+			continue
+		}
+
+		sheet, err := cuesheet.ParseFile(cue)
+		if err != nil {
+			continue
+		}
+		if _, ok := cueSheetSources(cue, sheet)[path]; ok {
+			return cue
+		}
+	}
+	return ""
+}
+
+// cueSheetSources returns the files of the given cue sheet that hold more
+// than one track, by path. Files that hold a single track are indexed as
+// usual.
+func cueSheetSources(path string, sheet *cuesheet.Sheet) map[string]*cuesheet.File {
+	dir := filepath.Dir(path)
+
+	sources := map[string]*cuesheet.File{}
+	for i := range sheet.Files {
+		f := &sheet.Files[i]
+		if len(f.Tracks) < 2 && (len(f.Tracks) == 0 || f.Tracks[0].Start == 0) {
+			continue
+		}
+
+		source := findCueSheetSource(dir, f.Name)
+		if source == "" {
+			slog.Warn("Cue sheet's source not found", "path", path, "file", f.Name)
+			continue
+		}
+		sources[source] = f
+	}
+	return sources
+}
+
+// findCueSheetSource returns the path of the given cue sheet's file. Since
+// rips are often re-encoded after the sheet is written, a supported file
+// with the same name but another extension is also accepted.
+func findCueSheetSource(dir, name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, `\`, "/"))
+	stem := strings.TrimSuffix(name, filepath.Ext(name))
+
+	candidates := []string{name}
+	for _, ext := range base.SupportedFileExtensions {
+		candidates = append(candidates, stem+ext)
+	}
+
+	for _, c := range candidates {
+		path := filepath.Join(dir, c)
+		if !base.IsSupportedFile(path) {
+			continue
+		}
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
 }
 
 // GetAllCollections returns all valid collections.
@@ -0,0 +1,347 @@
+package models
+
+import (
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+// scanProgressInterval defines how often the progress of a scan is
+// broadcast.
+const scanProgressInterval = 500 * time.Millisecond
+
+// ScanAction defines an action on a collection scan.
+type ScanAction int
+
+// ScanAction enum.
+const (
+	ScanActionNone ScanAction = iota
+	ScanActionCancel
+	ScanActionPause
+	ScanActionResume
+)
+
+func (sa ScanAction) String() string {
+	return []string{
+		"none",
+		"cancel",
+		"pause",
+		"resume",
+	}[sa]
+}
+
+// ScanProgress defines the progress of a collection scan.
+// Implements the ProtoOut interface.
+type ScanProgress struct {
+	CollectionID int64
+	Total        int // files found
+	Seen         int
+	Added        int
+	Updated      int
+	Unchanged    int
+	Removed      int
+	Failed       int
+	CurrentPath  string
+	ETA          time.Duration
+	Paused       bool
+	Canceled     bool
+}
+
+func (sp *ScanProgress) ToProtobuf() proto.Message {
+	return &m3uetcpb.ScanProgress{
+		CollectionId: sp.CollectionID,
+		Total:        int64(sp.Total),
+		Seen:         int64(sp.Seen),
+		Added:        int64(sp.Added),
+		Updated:      int64(sp.Updated),
+		Unchanged:    int64(sp.Unchanged),
+		Removed:      int64(sp.Removed),
+		Failed:       int64(sp.Failed),
+		CurrentPath:  sp.CurrentPath,
+		Eta:          sp.ETA.Nanoseconds(),
+		Paused:       sp.Paused,
+		Canceled:     sp.Canceled,
+	}
+}
+
+// scanState defines the controls of a scan, either running or waiting for
+// its turn.
+type scanState struct {
+	mu       sync.Mutex
+	canceled bool
+	resumed  chan struct{} // closed on resume, nil unless paused
+}
+
+var scans = struct {
+	mu sync.Mutex
+	m  map[int64]*scanState // by collection ID
+}{m: map[int64]*scanState{}}
+
+// ControlScan applies the given action to the scan of the collection with
+// the given ID.
+func ControlScan(id int64, action ScanAction) error {
+	scans.mu.Lock()
+	st, ok := scans.m[id]
+	scans.mu.Unlock()
+
+	if !ok {
+		return fmt.Errorf("Collection with ID=%v is not being scanned", id)
+	}
+	return st.control(action)
+}
+
+// registerScan returns the controls for a new scan of the collection with
+// the given ID, or false if the collection is already being scanned.
+func registerScan(id int64) (*scanState, bool) {
+	scans.mu.Lock()
+	defer scans.mu.Unlock()
+
+	if _, ok := scans.m[id]; ok {
+		return nil, false
+	}
+	st := &scanState{}
+	scans.m[id] = st
+	return st, true
+}
+
+func unregisterScan(id int64) {
+	scans.mu.Lock()
+	defer scans.mu.Unlock()
+
+	delete(scans.m, id)
+}
+
+func (st *scanState) control(action ScanAction) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	switch action {
+	case ScanActionCancel:
+		st.canceled = true
+		st.resume()
+	case ScanActionPause:
+		if st.resumed == nil && !st.canceled {
+			st.resumed = make(chan struct{})
+		}
+	case ScanActionResume:
+		st.resume()
+	default:
+		return fmt.Errorf("Unsupported scan action: %v", action)
+	}
+	return nil
+}
+
+func (st *scanState) resume() {
+	if st.resumed != nil {
+		close(st.resumed)
+		st.resumed = nil
+	}
+}
+
+// get returns whether the scan was canceled and, if paused, the channel
+// that is closed when resumed.
+func (st *scanState) get() (canceled bool, resumed <-chan struct{}) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.resumed != nil {
+		resumed = st.resumed
+	}
+	return st.canceled, resumed
+}
+
+func (st *scanState) isCanceled() bool {
+	canceled, _ := st.get()
+	return canceled
+}
+
+// scanJob defines a file whose tags are read by a scan worker.
+type scanJob struct {
+	t         *Track
+	path      string
+	fs        fileStat
+	checkHash bool // if the track may be unchanged, after all
+	unchanged bool
+	changed   bool // if the file stats changed
+	err       error
+}
+
+func (j *scanJob) run() {
+	if j.checkHash && !j.t.isModified(j.path, &j.fs) {
+		j.unchanged = true
+	} else {
+		j.fs.fillHash(j.path)
+		j.err = j.t.updateTags()
+	}
+	j.changed = j.t.setFileStat(j.fs)
+}
+
+// collectionScanner tags files through a pool of workers, while keeping
+// all the writes to the database in the scanning goroutine, and reports
+// the progress of the scan.
+type collectionScanner struct {
+	state    *scanState
+	tx       *gorm.DB
+	logw     *slog.Logger
+	progress ScanProgress
+	started  time.Time
+	reported time.Time
+
+	jobs    chan *scanJob
+	results chan *scanJob
+	pending int // jobs whose results were not saved yet
+}
+
+func newCollectionScanner(c *Collection, st *scanState,
+	logw *slog.Logger) *collectionScanner {
+
+	return &collectionScanner{
+		state:    st,
+		tx:       db.Session(&gorm.Session{SkipHooks: true}),
+		logw:     logw,
+		progress: ScanProgress{CollectionID: c.ID},
+		jobs:     make(chan *scanJob),
+		results:  make(chan *scanJob),
+	}
+}
+
+// start starts the workers, for the given number of files.
+func (s *collectionScanner) start(total int) {
+	s.progress.Total = total
+	s.started = time.Now()
+
+	for range max(1, base.Conf.Server.Collection.Scanning.Workers) {
+		go func() {
+			for j := range s.jobs {
+				j.run()
+				s.results <- j
+			}
+		}()
+	}
+}
+
+// stop stops the workers, after saving the pending results.
+func (s *collectionScanner) stop() {
+	close(s.jobs)
+	s.drain()
+	s.progress.CurrentPath = ""
+}
+
+// dispatch hands the given job over to the workers, saving the results
+// that arrive meanwhile.
+func (s *collectionScanner) dispatch(j *scanJob) {
+	for {
+		select {
+		case s.jobs <- j:
+			s.pending++
+			return
+		case r := <-s.results:
+			s.pending--
+			s.save(r)
+		}
+	}
+}
+
+// drain saves the results of all the jobs dispatched.
+func (s *collectionScanner) drain() {
+	for ; s.pending > 0; s.pending-- {
+		s.save(<-s.results)
+	}
+}
+
+func (s *collectionScanner) save(j *scanJob) {
+	logw := s.logw.With("location", j.t.Location)
+
+	if j.unchanged {
+		if j.changed {
+			if err := j.t.SaveTx(s.tx); err != nil {
+				logw.Warn("Failed to save file stats", "error", err)
+			}
+		}
+		s.progress.Unchanged++
+		return
+	}
+
+	isNew := j.t.ID == 0
+	if err := j.t.SaveTx(s.tx); err != nil {
+		logw.Warn("Failed to save track", "error", err)
+		s.progress.Failed++
+		return
+	}
+
+	switch {
+	case j.err != nil:
+		logw.Warn("Failed to update tags", "error", j.err)
+		s.progress.Failed++
+	case isNew:
+		s.progress.Added++
+	default:
+		s.progress.Updated++
+	}
+}
+
+// wait blocks while the scan is paused, letting other heavy tasks run, and
+// returns false if the scan was canceled.
+func (s *collectionScanner) wait() bool {
+	if s.progress.Canceled {
+		return false
+	}
+
+	canceled, resumed := s.state.get()
+	if resumed != nil {
+		s.drain()
+		s.progress.Paused = true
+		s.report(true)
+		s.logw.Info("Scan paused")
+
+		pausedAt := time.Now()
+		<-storageGuard
+		<-resumed
+		storageGuard <- struct{}{}
+
+		s.started = s.started.Add(time.Since(pausedAt))
+		s.progress.Paused = false
+		canceled = s.state.isCanceled()
+		if !canceled {
+			s.logw.Info("Scan resumed")
+			s.report(true)
+		}
+	}
+
+	if canceled {
+		s.logw.Info("Scan canceled")
+		s.progress.Canceled = true
+	}
+	return !canceled
+}
+
+// report broadcasts the progress of the scan, unless it was broadcast
+// recently and force is false.
+func (s *collectionScanner) report(force bool) {
+	now := time.Now()
+	if !force && now.Sub(s.reported) < scanProgressInterval {
+		return
+	}
+	s.reported = now
+
+	p := s.progress
+	if p.Seen > 0 && p.Total > p.Seen {
+		perFile := float64(now.Sub(s.started)) / float64(p.Seen)
+		p.ETA = time.Duration(perFile * float64(p.Total-p.Seen))
+	}
+
+	subscription.Broadcast(
+		subscription.ToCollectionStoreEvent,
+		subscription.Event{
+			Idx:  int(CollectionEventScanningProgress),
+			Data: &p,
+		},
+	)
+}
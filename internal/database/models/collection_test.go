@@ -0,0 +1,255 @@
+package models_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type fixturesDir string
+
+func (fd fixturesDir) FixturesDir() string {
+	return string(fd)
+}
+
+func TestCollectionScan(t *testing.T) {
+	db := tests.SetupTest(t, fixturesDir("models/collection-scan"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	dir := t.TempDir()
+	audio, err := os.ReadFile(filepath.Join("..", "..", "..", "data", "testing", "audio1", "track01.ogg"))
+	require.NoError(t, err)
+	for _, name := range []string{"a.ogg", "b.ogg"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), audio, 0644))
+	}
+
+	c := &models.Collection{}
+	require.NoError(t, c.Read(3))
+	c.Location, err = urlstr.PathToURL(dir)
+	require.NoError(t, err)
+	require.NoError(t, c.Save())
+
+	// readTrack returns the track for the given file in dir
+	readTrack := func(t *testing.T, name string) *models.Track {
+		location, err := urlstr.PathToURL(filepath.Join(dir, name))
+		require.NoError(t, err)
+
+		tr := &models.Track{}
+		require.NoError(t, db.Where("location = ?", location).First(tr).Error)
+		return tr
+	}
+
+	// stale marks the track's tags as outdated, so a re-tag can be told
+	stale := func(t *testing.T, tr *models.Track) {
+		require.NoError(t, db.Model(tr).Update("title", "stale").Error)
+	}
+
+	// touch changes the modification time of the given file in dir
+	touch := func(t *testing.T, name string, mtime time.Time) {
+		require.NoError(t, os.Chtimes(filepath.Join(dir, name), mtime, mtime))
+	}
+
+	c.Scan(false)
+
+	a, b := readTrack(t, "a.ogg"), readTrack(t, "b.ogg")
+	assert.Equal(t, int64(len(audio)), a.Filesize)
+	assert.NotZero(t, a.Filemtime)
+	assert.Empty(t, a.Filehash)
+
+	t.Run("unchanged files are not re-tagged", func(t *testing.T) {
+		stale(t, a)
+
+		c.Scan(false)
+		assert.Equal(t, "stale", readTrack(t, "a.ogg").Title)
+	})
+
+	t.Run("modified files are re-tagged", func(t *testing.T) {
+		stale(t, b)
+		mtime := time.Now().Add(time.Hour)
+		touch(t, "b.ogg", mtime)
+
+		c.Scan(false)
+		got := readTrack(t, "b.ogg")
+		assert.Equal(t, b.ID, got.ID)
+		assert.NotEqual(t, "stale", got.Title)
+		assert.Equal(t, mtime.UnixNano(), got.Filemtime)
+	})
+
+	t.Run("unchanged files are not re-tagged with update-tags", func(t *testing.T) {
+		c.Scan(true)
+		assert.Equal(t, "stale", readTrack(t, "a.ogg").Title)
+	})
+
+	t.Run("tracks without stats are re-tagged", func(t *testing.T) {
+		require.NoError(t, db.Model(a).Update("filemtime", 0).Error)
+
+		c.Scan(false)
+		got := readTrack(t, "a.ogg")
+		assert.NotEqual(t, "stale", got.Title)
+		assert.NotZero(t, got.Filemtime)
+	})
+
+	t.Run("touched files are not re-tagged if hashed", func(t *testing.T) {
+		base.Conf.Server.Collection.Scanning.Hash = true
+		t.Cleanup(func() { base.Conf.Server.Collection.Scanning.Hash = false })
+
+		// the first change records the hash
+		touch(t, "b.ogg", time.Now().Add(2*time.Hour))
+		c.Scan(false)
+		require.NotEmpty(t, readTrack(t, "b.ogg").Filehash)
+
+		stale(t, b)
+		mtime := time.Now().Add(3 * time.Hour)
+		touch(t, "b.ogg", mtime)
+
+		c.Scan(false)
+		got := readTrack(t, "b.ogg")
+		assert.Equal(t, "stale", got.Title)
+		assert.Equal(t, mtime.UnixNano(), got.Filemtime)
+	})
+
+	t.Run("touched files are re-tagged with update-tags, even if hashed", func(t *testing.T) {
+		base.Conf.Server.Collection.Scanning.Hash = true
+		t.Cleanup(func() { base.Conf.Server.Collection.Scanning.Hash = false })
+
+		stale(t, b)
+		touch(t, "b.ogg", time.Now().Add(4*time.Hour))
+
+		c.Scan(true)
+		assert.NotEqual(t, "stale", readTrack(t, "b.ogg").Title)
+	})
+
+	t.Run("deleted files are removed", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "a.ogg")))
+
+		c.Scan(false)
+		err := db.First(&models.Track{}, a.ID).Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+		assert.Equal(t, b.ID, readTrack(t, "b.ogg").ID)
+	})
+}
+
+func TestCollectionScanControl(t *testing.T) {
+	db := tests.SetupTest(t, fixturesDir("models/collection-scan"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	dir := t.TempDir()
+	audio, err := os.ReadFile(filepath.Join("..", "..", "..", "data", "testing", "audio1", "track01.ogg"))
+	require.NoError(t, err)
+	for _, name := range []string{"a.ogg", "b.ogg"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), audio, 0644))
+	}
+
+	c := &models.Collection{}
+	require.NoError(t, c.Read(3))
+	c.Location, err = urlstr.PathToURL(dir)
+	require.NoError(t, err)
+	require.NoError(t, c.Save())
+
+	progress := make(chan *models.ScanProgress, 100)
+	done := make(chan *models.ScanProgress, 1)
+
+	s, _ := subscription.Subscribe(subscription.ToCollectionStoreEvent)
+	t.Cleanup(s.Unsubscribe)
+	go func() {
+		for e := range s.Event {
+			sp, ok := e.Data.(*models.ScanProgress)
+			if !ok {
+				continue
+			}
+			switch models.CollectionEvent(e.Idx) {
+			case models.CollectionEventScanningProgress:
+				progress <- sp
+			case models.CollectionEventScanningDone:
+				done <- sp
+			}
+		}
+	}()
+
+	// scan starts a scan that waits for its turn, applies the given
+	// action, and lets the scan run
+	scan := func(t *testing.T, action models.ScanAction) {
+		models.LockStorage()
+		go c.Scan(false)
+		require.Eventually(t, func() bool { return models.IsScanning(c.ID) },
+			time.Second, 10*time.Millisecond)
+
+		require.NoError(t, models.ControlScan(c.ID, action))
+		models.UnlockStorage()
+	}
+
+	waitForDone := func(t *testing.T) *models.ScanProgress {
+		select {
+		case sp := <-done:
+			require.Eventually(t, func() bool { return !models.IsScanning(c.ID) },
+				time.Second, 10*time.Millisecond)
+			return sp
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "scan did not finish")
+		}
+		return nil
+	}
+
+	t.Run("only running scans can be controlled", func(t *testing.T) {
+		assert.Error(t, models.ControlScan(c.ID, models.ScanActionCancel))
+	})
+
+	t.Run("paused scans let other tasks run", func(t *testing.T) {
+		scan(t, models.ScanActionPause)
+
+		var sp *models.ScanProgress
+		require.Eventually(t, func() bool {
+			select {
+			case sp = <-progress:
+				return sp.Paused
+			default:
+				return false
+			}
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.Zero(t, sp.Seen)
+		assert.Equal(t, 2, sp.Total)
+
+		// does not block while paused
+		models.LockStorage()
+		models.UnlockStorage()
+
+		require.NoError(t, models.ControlScan(c.ID, models.ScanActionResume))
+		sp = waitForDone(t)
+		assert.False(t, sp.Canceled)
+		assert.Equal(t, 2, sp.Seen)
+		assert.Equal(t, 2, sp.Added)
+		assert.Zero(t, sp.Failed)
+	})
+
+	t.Run("canceled scans keep tracks", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "a.ogg")))
+
+		scan(t, models.ScanActionCancel)
+		sp := waitForDone(t)
+		assert.True(t, sp.Canceled)
+		assert.Zero(t, sp.Seen)
+		assert.Zero(t, sp.Removed)
+
+		var n int64
+		require.NoError(t, db.Model(&models.Track{}).Where("collection_id = ?", c.ID).Count(&n).Error)
+		assert.Equal(t, int64(2), n)
+	})
+
+	t.Run("rescans report unchanged files", func(t *testing.T) {
+		go c.Scan(false)
+		sp := waitForDone(t)
+		assert.Equal(t, 1, sp.Seen)
+		assert.Equal(t, 1, sp.Unchanged)
+		assert.Equal(t, 1, sp.Removed)
+	})
+}
@@ -0,0 +1,195 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+const (
+	// EqualizerBandsCount defines the number of equalizer bands.
+	EqualizerBandsCount = 10
+
+	// MinimumEqualizerGain defines the minimum gain of a band, in dB.
+	MinimumEqualizerGain = -24.0
+
+	// MaximumEqualizerGain defines the maximum gain of a band, in dB.
+	MaximumEqualizerGain = 12.0
+)
+
+// EqualizerBands defines the gains, in dB, of the ten equalizer bands,
+// centered at 29, 59, 119, 237, 474, 947, 1889, 3770, 7523 and 15011 Hz.
+type EqualizerBands [EqualizerBandsCount]float64
+
+// Validate returns an error if any of the gains is out of range.
+func (eb EqualizerBands) Validate() error {
+	for i, g := range eb {
+		if g < MinimumEqualizerGain || g > MaximumEqualizerGain {
+			return fmt.Errorf("band %d gain must be between %v and %v dB",
+				i, MinimumEqualizerGain, MaximumEqualizerGain)
+		}
+	}
+	return nil
+}
+
+// EqualizerPreset defines an equalizer_preset row.
+type EqualizerPreset struct {
+	Model
+	Name        string         `json:"name" gorm:"uniqueIndex:unique_idx_equalizer_preset_name,not null"`
+	Description string         `json:"description"`
+	Bands       EqualizerBands `json:"bands" gorm:"serializer:json"`
+	Genre       string         `json:"genre" gorm:"index:idx_equalizer_preset_genre"` // genre the preset applies to
+}
+
+func (ep *EqualizerPreset) Read(id int64) error {
+	return ep.ReadTx(db, id)
+}
+
+func (ep *EqualizerPreset) ReadTx(tx *gorm.DB, id int64) error {
+	return tx.First(ep, id).Error
+}
+
+func (ep *EqualizerPreset) Save() error {
+	return ep.SaveTx(db)
+}
+
+func (ep *EqualizerPreset) SaveTx(tx *gorm.DB) error {
+	ep.Name = strings.TrimSpace(ep.Name)
+	if ep.Name == "" {
+		return errors.New("equalizer preset name cannot be empty")
+	}
+	if err := ep.Bands.Validate(); err != nil {
+		return err
+	}
+	ep.Genre = strings.TrimSpace(ep.Genre)
+	return tx.Save(ep).Error
+}
+
+func (ep *EqualizerPreset) FromProtobuf(in proto.Message) {
+	protobufToEqualizerPreset(in.(*m3uetcpb.EqualizerPreset), ep)
+}
+
+func (ep *EqualizerPreset) ToProtobuf() proto.Message {
+	return &m3uetcpb.EqualizerPreset{
+		Id:          ep.ID,
+		Name:        ep.Name,
+		Description: ep.Description,
+		Bands:       ep.Bands[:],
+		Genre:       ep.Genre,
+		CreatedAt:   timestamppb.New(time.Unix(0, ep.CreatedAt)),
+		UpdatedAt:   timestamppb.New(time.Unix(0, ep.UpdatedAt)),
+	}
+}
+
+// Delete deletes an equalizer preset from the DB, along with any
+// assignment to it.
+func (ep *EqualizerPreset) Delete() error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&PlaybackSettings{}).
+			Where("equalizer_preset_id = ?", ep.ID).
+			Update("equalizer_preset_id", 0).
+			Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&Playbar{}).
+			Where("equalizer_preset_id = ?", ep.ID).
+			Update("equalizer_preset_id", 0).
+			Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(ep).Error
+	})
+}
+
+// EqualizerPresetFromProtobuf returns an equalizer preset from the given
+// protocol buffer.
+func EqualizerPresetFromProtobuf(in *m3uetcpb.EqualizerPreset) (ep *EqualizerPreset) {
+	ep = &EqualizerPreset{}
+	protobufToEqualizerPreset(in, ep)
+	return
+}
+
+// GetAllEqualizerPresets returns all equalizer presets, sorted by name.
+func GetAllEqualizerPresets() []*EqualizerPreset {
+	eps := []*EqualizerPreset{}
+	if err := db.Order("name").Find(&eps).Error; err != nil {
+		slog.Error("Failed to find equalizer presets in database", "error", err)
+	}
+	return eps
+}
+
+// ActivateEqualizerPreset selects the given preset for the given
+// perspective, or globally if no perspective is given. A zero ID
+// clears the selection.
+func ActivateEqualizerPreset(id int64, idx ...PerspectiveIndex) error {
+	slog.Info("Activating equalizer preset", "id", id, "idx", idx)
+
+	if id > 0 {
+		if err := (&EqualizerPreset{}).Read(id); err != nil {
+			return err
+		}
+	}
+
+	if len(idx) == 0 {
+		ps := GetPlaybackSettings()
+		ps.EqualizerPresetID = id
+		return ps.Save()
+	}
+
+	bar, err := idx[0].GetPlaybar()
+	if err != nil {
+		return err
+	}
+	return db.Model(bar).Update("equalizer_preset_id", id).Error
+}
+
+// GetEqualizerPresetFor returns the preset that applies to the given track
+// in the given perspective, if any.
+// A preset assigned to the track's genre takes precedence over the one
+// selected for the perspective, which takes precedence over the global one.
+func GetEqualizerPresetFor(t *Track, idx PerspectiveIndex) *EqualizerPreset {
+	ep := &EqualizerPreset{}
+
+	if t != nil && t.Genre != "" {
+		err := db.Where("genre = ? COLLATE NOCASE", t.Genre).
+			Limit(1).
+			Find(ep).
+			Error
+		if err == nil && ep.ID > 0 {
+			return ep
+		}
+	}
+
+	if bar, err := idx.GetPlaybar(); err == nil && bar.EqualizerPresetID > 0 {
+		if err := ep.Read(bar.EqualizerPresetID); err == nil {
+			return ep
+		}
+	}
+
+	if id := GetPlaybackSettings().EqualizerPresetID; id > 0 {
+		if err := ep.Read(id); err == nil {
+			return ep
+		}
+	}
+
+	return nil
+}
+
+func protobufToEqualizerPreset(in *m3uetcpb.EqualizerPreset, out *EqualizerPreset) {
+	out.Name = in.Name
+	out.Description = in.Description
+	out.Bands = EqualizerBands{}
+	copy(out.Bands[:], in.Bands)
+	out.Genre = in.Genre
+}
@@ -0,0 +1,26 @@
+package models
+
+// LockStorage holds heavy tasks back, as if one was running, until
+// UnlockStorage is called.
+func LockStorage() {
+	storageGuard <- struct{}{}
+}
+
+func UnlockStorage() {
+	<-storageGuard
+}
+
+// IsScanning returns true if the collection with the given ID is being
+// scanned, or waiting for its turn.
+func IsScanning(id int64) bool {
+	scans.mu.Lock()
+	defer scans.mu.Unlock()
+
+	_, ok := scans.m[id]
+	return ok
+}
+
+// FillReplayGain assigns the REPLAYGAIN_* values found in the given raw tags.
+func (t *Track) FillReplayGain(raw map[string]interface{}) {
+	t.fillReplayGain(raw)
+}
@@ -77,6 +77,8 @@ func (idx PerspectiveIndex) Activate() (err error) {
 	err = db.Where("id > 0").Save(&s).Error
 	if err == nil {
 		subscription.Broadcast(subscription.ToPerspectiveEvent)
+		// playback settings, such as repeat mode, depend on the perspective
+		subscription.Broadcast(subscription.ToPlaybackEvent)
 	}
 	return
 
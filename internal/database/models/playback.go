@@ -93,9 +93,23 @@ func (pb *Playback) ClearPending() {
 	onerror.Warn(err)
 }
 
-// GetNextToPlay returns the next playback entry to play.
-func (pb *Playback) GetNextToPlay() (err error) {
-	err = db.Where("played = 0").First(pb).Error
+// Discard marks the playback entry as played, without adding it to history.
+func (pb *Playback) Discard() {
+	err := db.Model(&Playback{}).
+		Where("played = 0 AND id = ?", pb.ID).
+		Update("played", 1).
+		Error
+	onerror.Warn(err)
+}
+
+// GetNextToPlay returns the next playback entry to play, ignoring the
+// excluded IDs (e.g., the one currently playing).
+func (pb *Playback) GetNextToPlay(excluded ...int64) (err error) {
+	tx := db.Where("played = 0")
+	if len(excluded) > 0 {
+		tx = tx.Where("id NOT IN ?", excluded)
+	}
+	err = tx.First(pb).Error
 	if err == nil && pb.ID == 0 {
 		err = fmt.Errorf("There's nothing in the playback queue")
 	}
@@ -0,0 +1,137 @@
+package models
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// PlaybackEvent defines a playback event.
+type PlaybackEvent int
+
+// PlaybackEvent enum.
+const (
+	PlaybackEventNone PlaybackEvent = iota
+	PlaybackEventError
+)
+
+func (pe PlaybackEvent) String() string {
+	return []string{
+		"none",
+		"error",
+	}[pe]
+}
+
+// FailureReason defines the reason a stream failed to play.
+type FailureReason int
+
+// FailureReason enum.
+const (
+	FailureReasonUnknown FailureReason = iota
+	FailureReasonMissingFile
+	FailureReasonUnsupportedCodec
+	FailureReasonNetwork
+)
+
+func (fr FailureReason) String() string {
+	return []string{
+		"unknown",
+		"missing-file",
+		"unsupported-codec",
+		"network",
+	}[fr]
+}
+
+// PlaybackFailure defines a playback_failure row, i.e., a location that
+// failed to play and was skipped.
+type PlaybackFailure struct {
+	Model
+	Location string        `json:"location" gorm:"uniqueIndex:unique_idx_playback_failure_location,not null"`
+	TrackID  int64         `json:"trackId"`
+	Reason   FailureReason `json:"reason"`
+	Message  string        `json:"message"`
+	Attempts int           `json:"attempts"`
+}
+
+func (pf *PlaybackFailure) Read(id int64) error {
+	return pf.ReadTx(db, id)
+}
+
+func (pf *PlaybackFailure) ReadTx(tx *gorm.DB, id int64) error {
+	return tx.First(pf, id).Error
+}
+
+func (pf *PlaybackFailure) Save() error {
+	return pf.SaveTx(db)
+}
+
+func (pf *PlaybackFailure) SaveTx(tx *gorm.DB) error {
+	return tx.Save(pf).Error
+}
+
+func (pf *PlaybackFailure) ToProtobuf() proto.Message {
+	return &m3uetcpb.PlaybackFailure{
+		Id:        pf.ID,
+		Location:  pf.Location,
+		TrackId:   pf.TrackID,
+		Reason:    m3uetcpb.PlaybackFailureReason(pf.Reason),
+		Message:   pf.Message,
+		Attempts:  int32(pf.Attempts),
+		CreatedAt: timestamppb.New(time.Unix(0, pf.CreatedAt)),
+		UpdatedAt: timestamppb.New(time.Unix(0, pf.UpdatedAt)),
+	}
+}
+
+// AddPlaybackFailure records the failure of the given playback, counting
+// the attempts made for the same location.
+func AddPlaybackFailure(pb *Playback, reason FailureReason, msg string) *PlaybackFailure {
+	logw := slog.With(
+		"location", pb.Location,
+		"reason", reason,
+	)
+	logw.Info("Adding playback failure", "message", msg)
+
+	pf := &PlaybackFailure{}
+	err := db.Where("location = ?", pb.Location).Limit(1).Find(pf).Error
+	if err != nil {
+		logw.Error("Failed to find playback failure", "error", err)
+	}
+
+	pf.Location = pb.Location
+	pf.TrackID = pb.TrackID
+	pf.Reason = reason
+	pf.Message = msg
+	pf.Attempts++
+	if err := pf.Save(); err != nil {
+		logw.Error("Failed to save playback failure", "error", err)
+	}
+	return pf
+}
+
+// DeletePlaybackFailures removes the given playback failures, or all of
+// them if no IDs are given.
+func DeletePlaybackFailures(ids []int64) error {
+	tx := db.Where("1 = 1")
+	if len(ids) > 0 {
+		tx = db.Where("id IN ?", ids)
+	}
+	return tx.Delete(&PlaybackFailure{}).Error
+}
+
+// GetPlaybackFailures returns the given playback failures, or all of them
+// if no IDs are given, newest first.
+func GetPlaybackFailures(ids []int64) []*PlaybackFailure {
+	pfs := []*PlaybackFailure{}
+	tx := db.Order("updated_at DESC")
+	if len(ids) > 0 {
+		tx = tx.Where("id IN ?", ids)
+	}
+	if err := tx.Find(&pfs).Error; err != nil {
+		slog.Error("Failed to find playback failures", "error", err)
+	}
+	return pfs
+}
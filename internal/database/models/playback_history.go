@@ -92,5 +92,7 @@ func AddPlaybackToHistory(id, position, duration int64, freeze bool) {
 			t.Duration = duration
 		}
 		onerrorw.Warn(t.Save())
+
+		SaveResumePosition(t.ID, position, t.Duration)
 	}
 }
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+// PlaybackSettings defines the persisted playback settings.
+// There is a single playback_settings row.
+type PlaybackSettings struct {
+	Model
+	Volume            float64 `json:"volume" gorm:"not null"`
+	Mute              bool    `json:"mute"`
+	EqualizerPresetID int64   `json:"equalizerPresetId" gorm:"default:0"` // soft reference, zero for flat
+	OutputSink        string  `json:"outputSink" gorm:"default:''"`       // empty for the configured one
+	OutputDevice      string  `json:"outputDevice" gorm:"default:''"`
+}
+
+func (ps *PlaybackSettings) Save() error {
+	return ps.SaveTx(db)
+}
+
+func (ps *PlaybackSettings) SaveTx(tx *gorm.DB) error {
+	return tx.Save(ps).Error
+}
+
+// GetPlaybackSettings returns the playback settings, creating them if needed.
+func GetPlaybackSettings() *PlaybackSettings {
+	ps := &PlaybackSettings{}
+	err := db.
+		Where(PlaybackSettings{Model: Model{ID: 1}}).
+		Attrs(PlaybackSettings{Volume: 1}).
+		FirstOrCreate(ps).
+		Error
+	if err != nil {
+		slog.Error("Failed to get playback settings", "error", err)
+		ps.Volume = 1
+	}
+	return ps
+}
@@ -65,11 +65,45 @@ func (ce PlaybarEvent) String() string {
 	}[ce]
 }
 
+// RepeatMode defines the repeat mode of a playbar.
+type RepeatMode int
+
+// RepeatMode enum.
+const (
+	RepeatNone RepeatMode = iota
+	RepeatTrack
+	RepeatPlaylist
+)
+
+// RepeatModeStrings returns the string list of repeat modes.
+func RepeatModeStrings() []string {
+	return []string{"None", "Track", "Playlist"}
+}
+
+func (rm RepeatMode) String() string {
+	return RepeatModeStrings()[rm]
+}
+
+// RepeatModeFromString returns the repeat mode for the given string.
+func RepeatModeFromString(s string) (rm RepeatMode, err error) {
+	for i, v := range RepeatModeStrings() {
+		if strings.EqualFold(v, s) {
+			rm = RepeatMode(i)
+			return
+		}
+	}
+	err = fmt.Errorf("Invalid repeat mode: %v", s)
+	return
+}
+
 // Playbar defines the playlist bar for each perspective.
 type Playbar struct {
 	Model
-	PerspectiveID int64       `json:"perspectiveId" gorm:"uniqueIndex:unique_idx_playbar_perspective_id,not null"`
-	Perspective   Perspective `json:"perspective" gorm:"foreignKey:PerspectiveID"`
+	PerspectiveID     int64       `json:"perspectiveId" gorm:"uniqueIndex:unique_idx_playbar_perspective_id,not null"`
+	Perspective       Perspective `json:"perspective" gorm:"foreignKey:PerspectiveID"`
+	Repeat            RepeatMode  `json:"repeat" gorm:"not null;default:0"`
+	Shuffle           bool        `json:"shuffle" gorm:"default:0"`
+	EqualizerPresetID int64       `json:"equalizerPresetId" gorm:"default:0"` // soft reference, zero for the global preset
 }
 
 func (b *Playbar) Read(id int64) error {
@@ -108,6 +142,22 @@ func (b *Playbar) ActivateEntry(pl *Playlist) {
 	onerror.NewRecorder(logw).Log(db.Save(&pls).Error)
 }
 
+// SetRepeatMode sets the repeat mode for the playbar.
+func (b *Playbar) SetRepeatMode(rm RepeatMode) (err error) {
+	slog.Info("Setting repeat mode", "playbar_id", b.ID, "repeat", rm)
+
+	err = db.Model(b).Update("repeat", rm).Error
+	return
+}
+
+// SetShuffle enables or disables shuffle for the playbar.
+func (b *Playbar) SetShuffle(shuffle bool) (err error) {
+	slog.Info("Setting shuffle", "playbar_id", b.ID, "shuffle", shuffle)
+
+	err = db.Model(b).Update("shuffle", shuffle).Error
+	return
+}
+
 // AppendToPlaylist -.
 func (b *Playbar) AppendToPlaylist(pl *Playlist, trackIds []int64,
 	locations []string) {
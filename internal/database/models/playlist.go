@@ -322,6 +322,56 @@ func (pl *Playlist) GetTrackAfter(curr PlaylistTrack,
 	return
 }
 
+// GetFirstTrack returns the first track in the playlist.
+func (pl *Playlist) GetFirstTrack() (pt *PlaylistTrack, err error) {
+	first := &PlaylistTrack{}
+	err = db.Where("playlist_id = ?", pl.ID).
+		Order("position ASC").
+		First(first).
+		Error
+	if err != nil {
+		return
+	}
+
+	pt = &PlaylistTrack{}
+	err = db.Joins("Playlist").
+		Joins("Track").
+		First(pt, first.ID).
+		Error
+	return
+}
+
+// GetPlaylistTrackIDs returns the IDs of all the playlist tracks, in
+// position order.
+func (pl *Playlist) GetPlaylistTrackIDs() (ids []int64) {
+	err := db.Model(&PlaylistTrack{}).
+		Where("playlist_id = ?", pl.ID).
+		Order("position ASC").
+		Pluck("id", &ids).
+		Error
+	if err != nil {
+		slog.Error("Failed to find playlist tracks in database", "error", err)
+	}
+	return
+}
+
+// GetShuffledTrackIDs returns the IDs of all the playlist tracks, in random
+// order, with the given ID (if any) first.
+func (pl *Playlist) GetShuffledTrackIDs(first int64) []int64 {
+	ids := pl.GetPlaylistTrackIDs()
+
+	shuffled := make([]int64, 0, len(ids))
+	if slices.Contains(ids, first) {
+		shuffled = append(shuffled, first)
+	}
+	for _, i := range getSuffler(len(ids)) {
+		if ids[i] != first {
+			shuffled = append(shuffled, ids[i])
+		}
+	}
+	return shuffled
+}
+
 // GetTrackAt returns the track at the given position.
 func (pl *Playlist) GetTrackAt(position int) (pt *PlaylistTrack, err error) {
 	at := &PlaylistTrack{}
@@ -364,6 +414,21 @@ func (pl *Playlist) GetTracks(limit int) ([]*PlaylistTrack, []*Track) {
 	return pointers.FromSlice(pts), pointers.FromSlice(ts)
 }
 
+// GetCover returns the cover of the first track in the playlist that has
+// one, if any.
+func (pl *Playlist) GetCover() string {
+	t := Track{}
+	err := db.Joins("JOIN playlist_track ON playlist_track.track_id = track.id").
+		Where("playlist_track.playlist_id = ? AND track.cover <> ''", pl.ID).
+		Order("playlist_track.position ASC").
+		Take(&t).
+		Error
+	if err != nil {
+		return ""
+	}
+	return t.Cover
+}
+
 func (pl *Playlist) createTracks(trackIds []int64,
 	locations []string) (pts []PlaylistTrack, err error) {
 
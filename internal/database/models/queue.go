@@ -124,9 +124,11 @@ func (q *Queue) DeleteAt(position int) {
 		}
 	}
 
-	if err := db.Save(&s).Error; err != nil {
-		logw.Error("Failed to save queue tracks", "error", err)
-		return
+	if len(s) > 0 {
+		if err := db.Save(&s).Error; err != nil {
+			logw.Error("Failed to save queue tracks", "error", err)
+			return
+		}
 	}
 
 	subscription.Broadcast(subscription.ToQueueStoreEvent)
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// resumeEndThreshold defines how close to the end a track has to be
+// stopped, to be considered finished.
+const resumeEndThreshold = 10 * time.Second
+
+// ResumePosition defines a resume_position row.
+type ResumePosition struct {
+	Model
+	Position int64 `json:"position"`
+	Duration int64 `json:"duration"`
+	TrackID  int64 `json:"trackId" gorm:"uniqueIndex:unique_idx_resume_position_track_id,not null"`
+}
+
+func (rp *ResumePosition) Save() error {
+	return rp.SaveTx(db)
+}
+
+func (rp *ResumePosition) SaveTx(tx *gorm.DB) error {
+	return tx.Save(rp).Error
+}
+
+func (rp *ResumePosition) ToProtobuf() proto.Message {
+	return &m3uetcpb.ResumePosition{
+		TrackId:   rp.TrackID,
+		Position:  rp.Position,
+		Duration:  rp.Duration,
+		UpdatedAt: timestamppb.New(time.Unix(0, rp.UpdatedAt)),
+	}
+}
+
+// ClearResumePosition removes the saved position for the given track.
+func ClearResumePosition(trackID int64) {
+	err := db.Where("track_id = ?", trackID).Delete(&ResumePosition{}).Error
+	if err != nil {
+		slog.Error("Failed to delete resume position", "track_id", trackID, "error", err)
+	}
+}
+
+// GetResumePosition returns the saved position for the given track, if the
+// track's perspective resumes playback.
+func GetResumePosition(trackID int64) int64 {
+	if trackID == 0 || !resumesPlayback(trackID) {
+		return 0
+	}
+
+	rp := &ResumePosition{}
+	err := db.Where("track_id = ?", trackID).Limit(1).Find(rp).Error
+	if err != nil {
+		slog.Error("Failed to find resume position", "track_id", trackID, "error", err)
+		return 0
+	}
+	return rp.Position
+}
+
+// GetResumePositions returns the saved positions for the given track IDs,
+// or all of them if no IDs are given.
+func GetResumePositions(trackIDs []int64) []*ResumePosition {
+	rps := []*ResumePosition{}
+	tx := db.Order("updated_at DESC")
+	if len(trackIDs) > 0 {
+		tx = tx.Where("track_id IN ?", trackIDs)
+	}
+	if err := tx.Find(&rps).Error; err != nil {
+		slog.Error("Failed to find resume positions", "error", err)
+	}
+	return rps
+}
+
+// SaveResumePosition saves the position the given track was stopped at,
+// if the track's perspective resumes playback. If the track was played to
+// the end, the saved position is removed instead.
+func SaveResumePosition(trackID, position, duration int64) {
+	if trackID == 0 || !resumesPlayback(trackID) {
+		return
+	}
+
+	logw := slog.With(
+		"track_id", trackID,
+		"position", position,
+		"duration", duration,
+	)
+
+	rp := &ResumePosition{}
+	err := db.Where("track_id = ?", trackID).Limit(1).Find(rp).Error
+	if err != nil {
+		logw.Error("Failed to find resume position", "error", err)
+		return
+	}
+
+	if position <= 0 ||
+		(duration > 0 && duration-position < int64(resumeEndThreshold)) {
+		if rp.ID > 0 {
+			logw.Info("Removing resume position")
+			ClearResumePosition(trackID)
+		}
+		return
+	}
+
+	logw.Info("Saving resume position")
+
+	rp.TrackID = trackID
+	rp.Position = position
+	rp.Duration = duration
+	if err := rp.Save(); err != nil {
+		logw.Error("Failed to save resume position", "error", err)
+	}
+}
+
+// resumesPlayback returns true if the track belongs to a perspective that
+// resumes playback.
+func resumesPlayback(trackID int64) bool {
+	var idx []int
+	err := db.Model(&Track{}).
+		Joins("JOIN collection ON track.collection_id = collection.id").
+		Joins("JOIN perspective ON collection.perspective_id = perspective.id").
+		Where("track.id = ?", trackID).
+		Pluck("perspective.idx", &idx).
+		Error
+	if err != nil || len(idx) == 0 {
+		return false
+	}
+
+	name := PerspectiveIndex(idx[0]).String()
+	return slices.ContainsFunc(
+		base.Conf.Server.Playback.Resume.Perspectives,
+		func(s string) bool { return strings.EqualFold(s, name) },
+	)
+}
@@ -0,0 +1,164 @@
+package models
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// ScrobbleMaxAttempts defines how many times the submission of a scrobble
+// is attempted before giving up on it. Scrobbles given up on can still be
+// exported.
+const ScrobbleMaxAttempts = 10
+
+// ScrobbleQueued is signaled when a scrobble is added to the outbox.
+var ScrobbleQueued = make(chan struct{}, 1)
+
+// Scrobble defines a scrobble row, i.e., a play waiting in the outbox to
+// be submitted to the scrobbling service, or already submitted.
+type Scrobble struct {
+	Model
+	TrackID     int64  `json:"trackId" gorm:"index:idx_scrobble_track_id"`
+	Artist      string `json:"artist"`
+	Title       string `json:"title"`
+	Album       string `json:"album"`
+	Albumartist string `json:"albumartist"`
+	Tracknumber int    `json:"tracknumber"`
+	Duration    int64  `json:"duration"`
+	PlayedAt    int64  `json:"playedAt" gorm:"index:idx_scrobble_played_at"`  // when the play started
+	Submitted   int64  `json:"submitted" gorm:"index:idx_scrobble_submitted"` // 0 while pending
+	Attempts    int    `json:"attempts"`
+	LastError   string `json:"lastError"`
+}
+
+func (s *Scrobble) Create() error {
+	return s.CreateTx(db)
+}
+
+func (s *Scrobble) CreateTx(tx *gorm.DB) error {
+	return tx.Create(s).Error
+}
+
+func (s *Scrobble) Save() error {
+	return s.SaveTx(db)
+}
+
+func (s *Scrobble) SaveTx(tx *gorm.DB) error {
+	return tx.Save(s).Error
+}
+
+func (s *Scrobble) ToProtobuf() proto.Message {
+	var submitted *timestamppb.Timestamp
+	if s.Submitted > 0 {
+		submitted = timestamppb.New(time.Unix(0, s.Submitted))
+	}
+
+	return &m3uetcpb.Scrobble{
+		Id:          s.ID,
+		TrackId:     s.TrackID,
+		Artist:      s.Artist,
+		Title:       s.Title,
+		Album:       s.Album,
+		Duration:    s.Duration,
+		PlayedAt:    timestamppb.New(time.Unix(0, s.PlayedAt)),
+		SubmittedAt: submitted,
+		Attempts:    int32(s.Attempts),
+		LastError:   s.LastError,
+	}
+}
+
+// AddScrobble adds to the outbox a play of the given track, that started
+// at the given time.
+func AddScrobble(t *Track, playedAt time.Time) (*Scrobble, error) {
+	artist := t.Artist
+	if artist == "" {
+		artist = t.Albumartist
+	}
+
+	s := &Scrobble{
+		TrackID:     t.ID,
+		Artist:      artist,
+		Title:       t.Title,
+		Album:       t.Album,
+		Albumartist: t.Albumartist,
+		Tracknumber: t.Tracknumber,
+		Duration:    t.Duration,
+		PlayedAt:    playedAt.UnixNano(),
+	}
+	if err := s.Create(); err != nil {
+		return nil, err
+	}
+
+	slog.Info("Scrobble added to outbox", "track_id", t.ID, "played_at", playedAt)
+
+	if len(ScrobbleQueued) < 1 {
+		ScrobbleQueued <- struct{}{}
+	}
+	return s, nil
+}
+
+// GetPendingScrobbles returns up to limit scrobbles that are still to be
+// submitted, oldest first.
+func GetPendingScrobbles(limit int) []*Scrobble {
+	ss := []*Scrobble{}
+	err := db.Where("submitted = 0 AND attempts < ?", ScrobbleMaxAttempts).
+		Order("played_at ASC").
+		Limit(limit).
+		Find(&ss).
+		Error
+	if err != nil {
+		slog.Error("Failed to find pending scrobbles", "error", err)
+	}
+	return ss
+}
+
+// GetScrobbles returns the scrobbles in the outbox, newest first. Only
+// those not submitted are returned if pending is true, and all of them if
+// limit is zero.
+func GetScrobbles(pending bool, limit int) []*Scrobble {
+	ss := []*Scrobble{}
+	tx := db.Order("played_at DESC")
+	if pending {
+		tx = tx.Where("submitted = 0")
+	}
+	if limit > 0 {
+		tx = tx.Limit(limit)
+	}
+	if err := tx.Find(&ss).Error; err != nil {
+		slog.Error("Failed to find scrobbles", "error", err)
+	}
+	return ss
+}
+
+// MarkScrobblesSubmitted marks the given scrobbles as submitted.
+func MarkScrobblesSubmitted(ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.Model(&Scrobble{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"submitted":  time.Now().UnixNano(),
+			"last_error": "",
+		}).
+		Error
+}
+
+// MarkScrobblesFailed records a failed attempt to submit the given
+// scrobbles.
+func MarkScrobblesFailed(ids []int64, msg string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return db.Model(&Scrobble{}).
+		Where("id IN ?", ids).
+		Updates(map[string]any{
+			"attempts":   gorm.Expr("attempts + 1"),
+			"last_error": msg,
+		}).
+		Error
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+)
+
+// StationHistory defines a station_history row, i.e., a title heard while
+// playing a remote stream.
+type StationHistory struct {
+	Model
+	Location     string `json:"location" gorm:"index:idx_station_history_location,not null"`
+	Title        string `json:"title"`
+	Artist       string `json:"artist"`
+	Organization string `json:"organization"`
+}
+
+func (sh *StationHistory) Create() error {
+	return sh.CreateTx(db)
+}
+
+func (sh *StationHistory) CreateTx(tx *gorm.DB) error {
+	return tx.Create(sh).Error
+}
+
+func (sh *StationHistory) ToProtobuf() proto.Message {
+	return &m3uetcpb.StationHistory{
+		Id:           sh.ID,
+		Location:     sh.Location,
+		Title:        sh.Title,
+		Artist:       sh.Artist,
+		Organization: sh.Organization,
+		CreatedAt:    timestamppb.New(time.Unix(0, sh.CreatedAt)),
+	}
+}
+
+// AddToStationHistory records the given title as heard in the given
+// station, unless it is the last one recorded for it, and discards the
+// oldest entries beyond the configured limit.
+func AddToStationHistory(location, title, artist, organization string) {
+	title = strings.TrimSpace(title)
+	if location == "" || title == "" {
+		return
+	}
+
+	logw := slog.With(
+		"location", location,
+		"title", title,
+		"artist", artist,
+	)
+
+	last := &StationHistory{}
+	err := db.Where("location = ?", location).
+		Order("id DESC").
+		Limit(1).
+		Find(last).
+		Error
+	if err != nil {
+		logw.Error("Failed to find last station history entry", "error", err)
+		return
+	}
+	if last.ID > 0 && last.Title == title && last.Artist == artist {
+		return
+	}
+
+	logw.Debug("Adding title to station history")
+
+	sh := &StationHistory{
+		Location:     location,
+		Title:        title,
+		Artist:       artist,
+		Organization: organization,
+	}
+	if err := sh.Create(); err != nil {
+		logw.Error("Failed to add title to station history", "error", err)
+		return
+	}
+
+	limit := base.Conf.Server.Playback.Stream.HistoryLimit
+	if limit <= 0 {
+		return
+	}
+	err = db.Where("location = ?", location).
+		Where("id NOT IN (?)",
+			db.Model(&StationHistory{}).
+				Select("id").
+				Where("location = ?", location).
+				Order("id DESC").
+				Limit(limit),
+		).
+		Delete(&StationHistory{}).
+		Error
+	if err != nil {
+		logw.Error("Failed to trim station history", "error", err)
+	}
+}
+
+// GetStationHistory returns the titles heard in the given station, or in
+// all stations if no location is given, newest first.
+func GetStationHistory(location string, limit int) []*StationHistory {
+	shs := []*StationHistory{}
+	tx := db.Order("id DESC")
+	if location != "" {
+		tx = tx.Where("location = ?", location)
+	}
+	if limit > 0 {
+		tx = tx.Limit(limit)
+	}
+	if err := tx.Find(&shs).Error; err != nil {
+		slog.Error("Failed to find station history", "error", err)
+	}
+	return shs
+}
@@ -4,6 +4,8 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"path/filepath"
@@ -18,6 +20,7 @@ import (
 	"github.com/jwmwalrus/gear-pieces/idler"
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
 	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
 	"github.com/jwmwalrus/m3u-etcetera/internal/discover"
 	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
 	rtc "github.com/jwmwalrus/rtcycler"
@@ -50,11 +53,20 @@ type Track struct {
 	Date        int64  `json:"date" gorm:"index:idx_track_date"`
 	Duration    int64  `json:"duration"`
 
+	Trackgain float64 `json:"trackgain"` // ReplayGain, in dB
+	Trackpeak float64 `json:"trackpeak"`
+	Albumgain float64 `json:"albumgain"` // ReplayGain, in dB
+	Albumpeak float64 `json:"albumpeak"`
+
 	Rating       int        `json:"rating" gorm:"index:idx_track_rating"`
 	Playcount    int        `json:"playcount,"`
 	Remote       bool       `json:"remote"` // if track is remote but not in a remote collection
 	Lastplayed   int64      `json:"lastplayed"`
 	Tags         string     `json:"tags"`
+	Cuesheet     string     `json:"cuesheet" gorm:"index:idx_track_cuesheet"` // for virtual tracks, the cue sheet's location
+	Filesize     int64      `json:"filesize"`                                 // as of the last scan
+	Filemtime    int64      `json:"filemtime"`                                // as of the last scan, in nanoseconds
+	Filehash     string     `json:"filehash"`                                 // as of the last scan, if hashing is enabled
 	CollectionID int64      `json:"collectionId" gorm:"index:idx_track_collection_id,not null"`
 	Collection   Collection `json:"collection" gorm:"foreignKey:CollectionID"`
 }
@@ -99,7 +111,7 @@ func (t *Track) ToProtobuf() proto.Message {
 
 	var dangling bool
 	if !t.Remote {
-		path, err := urlstr.URLToPath(t.Location)
+		path, err := urlstr.URLToPath(cuesheet.Source(t.Location))
 		if err == nil {
 			if _, err = os.Stat(path); errors.Is(err, os.ErrNotExist) {
 				dangling = true
@@ -191,6 +203,76 @@ func (t *Track) AfterDelete(tx *gorm.DB) error {
 	return nil
 }
 
+// PrecedesInAlbum returns true if the given track comes right after this
+// one in the same album.
+func (t *Track) PrecedesInAlbum(next *Track) bool {
+	if t.Album == "" ||
+		t.Album != next.Album ||
+		t.Albumartist != next.Albumartist {
+		return false
+	}
+
+	if t.Discnumber == next.Discnumber {
+		return next.Tracknumber == t.Tracknumber+1
+	}
+	return next.Discnumber == t.Discnumber+1 && next.Tracknumber == 1
+}
+
+// fillFromCueSheet assigns the tags given by the cue sheet, falling back
+// to those of the whole source, st, if any.
+func (t *Track) fillFromCueSheet(st *Track, sheet *cuesheet.Sheet, ct *cuesheet.Track) {
+	if st == nil {
+		st = &Track{}
+	}
+
+	firstOf := func(values ...string) string {
+		for _, v := range values {
+			if strings.TrimSpace(v) != "" {
+				return v
+			}
+		}
+		return ""
+	}
+
+	t.Format = st.Format
+	t.Type = st.Type
+	t.Cover = st.Cover
+	t.Title = firstOf(ct.Title, fmt.Sprintf("Track %02d", ct.Number))
+	t.Album = firstOf(sheet.Title, st.Album)
+	t.Artist = firstOf(ct.Performer, sheet.Performer, st.Artist)
+	t.Albumartist = firstOf(sheet.Performer, st.Albumartist)
+	t.Composer = firstOf(ct.Songwriter, sheet.Songwriter, st.Composer)
+	t.Genre = firstOf(sheet.Genre, st.Genre)
+	t.Tracknumber = ct.Number
+	t.Tracktotal = sheet.Tracktotal()
+	t.Discnumber, t.Disctotal = st.Discnumber, st.Disctotal
+	if sheet.Discnumber > 0 {
+		t.Discnumber, t.Disctotal = sheet.Discnumber, sheet.Disctotal
+	}
+
+	t.Year, t.Date = st.Year, st.Date
+	if len(sheet.Date) >= 4 {
+		if year, err := strconv.Atoi(sheet.Date[:4]); err == nil {
+			t.Year = year
+		}
+	}
+
+	t.Trackgain, t.Trackpeak = ct.Trackgain, ct.Trackpeak
+	t.Albumgain, t.Albumpeak = st.Albumgain, st.Albumpeak
+	if sheet.Albumgain != 0 {
+		t.Albumgain, t.Albumpeak = sheet.Albumgain, sheet.Albumpeak
+	}
+
+	switch {
+	case ct.End > 0:
+		t.Duration = ct.End - ct.Start
+	case st.Duration > ct.Start:
+		t.Duration = st.Duration - ct.Start
+	default:
+		t.Duration = 0
+	}
+}
+
 func (t *Track) createTransient(tx *gorm.DB, raw map[string]interface{}) (err error) {
 	c, err := TransientCollection.Get()
 	if err != nil {
@@ -208,7 +290,7 @@ func (t *Track) createTransient(tx *gorm.DB, raw map[string]interface{}) (err er
 
 func (t *Track) discoverDuration() {
 	slog.Debug("Discovering duration", "location", t.Location)
-	info, err := discover.Execute(t.Location)
+	info, err := discover.Execute(cuesheet.Source(t.Location))
 	if err != nil {
 		slog.Error("Failed to execute `discover`", "error", err)
 		return
@@ -321,6 +403,52 @@ func (t *Track) fillMissingTags(raw map[string]interface{}) {
 	}
 }
 
+// fillReplayGain assigns the REPLAYGAIN_* values found in the given raw tags.
+func (t *Track) fillReplayGain(raw map[string]interface{}) {
+	t.Trackgain, t.Trackpeak = 0, 0
+	t.Albumgain, t.Albumpeak = 0, 0
+
+	for k, v := range raw {
+		var str string
+		switch val := v.(type) {
+		case string:
+			str = val
+		case *tag.Comm:
+			// ID3v2 TXXX frames
+			k = val.Description
+			str = val.Text
+		default:
+			continue
+		}
+
+		var dst *float64
+		switch strings.ToLower(k) {
+		case "replaygain_track_gain":
+			dst = &t.Trackgain
+		case "replaygain_track_peak":
+			dst = &t.Trackpeak
+		case "replaygain_album_gain":
+			dst = &t.Albumgain
+		case "replaygain_album_peak":
+			dst = &t.Albumpeak
+		default:
+			continue
+		}
+
+		str = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(str)), "db")
+		f, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+		if err != nil {
+			slog.With(
+				"location", t.Location,
+				"tag", k,
+				"value", v,
+			).Warn("Invalid ReplayGain value")
+			continue
+		}
+		*dst = f
+	}
+}
+
 func (t *Track) savePicture(p *tag.Picture, sum string) {
 	if p == nil || base.Conf.Server.Collection.Scanning.SkipCover {
 		return
@@ -334,7 +462,7 @@ func (t *Track) savePicture(p *tag.Picture, sum string) {
 		logw := slog.With("file", fn)
 
 		file := filepath.Join(base.CoversDir(), fn)
-		err := os.WriteFile(file, p.Data, 0644)
+		err := writeCover(file, p.Data)
 		if err != nil {
 			logw.Error("Failed to write picture info file", "error", err)
 			return
@@ -344,12 +472,37 @@ func (t *Track) savePicture(p *tag.Picture, sum string) {
 	}
 }
 
+// writeCover writes the given data to a temporary file that replaces the
+// given one, so that readers, or other scan workers writing the cover of
+// the same album, never see a truncated image.
+func writeCover(file string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(file), filepath.Base(file)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Chmod(0644)
+	}
+	if errc := f.Close(); err == nil {
+		err = errc
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), file)
+}
+
 func (t *Track) updateTags() (err error) {
 	idler.GetBusy(idler.StatusFileOperations)
 	defer idler.GetFree(idler.StatusFileOperations)
 
+	source, start, end, virtual := cuesheet.SplitLocation(t.Location)
+
 	var path string
-	path, err = urlstr.URLToPath(t.Location)
+	path, err = urlstr.URLToPath(source)
 	if err != nil {
 		slog.With(
 			"location", t.Location,
@@ -399,35 +552,117 @@ func (t *Track) updateTags() (err error) {
 	}
 
 	t.fillMissingTags(raw)
+	t.fillReplayGain(raw)
+
+	if virtual {
+		// the tags belong to the whole source
+		t.Tracknumber, t.Tracktotal = 0, 0
+		t.Trackgain, t.Trackpeak = 0, 0
+		t.Duration = max(0, end-start)
+	}
 
 	if t.Duration == 0 {
 		t.discoverDuration()
+		if virtual && t.Duration > start {
+			t.Duration -= start
+		}
 	}
 
 	return
 }
 
+// fileStat defines the stats of a file that tell whether it changed since
+// the last scan.
+type fileStat struct {
+	size  int64
+	mtime int64 // in nanoseconds
+	hash  string
+}
+
+func newFileStat(i os.FileInfo) fileStat {
+	return fileStat{size: i.Size(), mtime: i.ModTime().UnixNano()}
+}
+
+// fillHash computes the hash of the file at the given path, if hashing is
+// enabled and it was not computed yet.
+func (fs *fileStat) fillHash(path string) {
+	if fs.hash != "" || !base.Conf.Server.Collection.Scanning.Hash {
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		slog.Warn("Failed to open file for hashing", "path", path, "error", err)
+		return
+	}
+	defer f.Close()
+
+	hasher := md5.New()
+	if _, err = io.Copy(hasher, f); err != nil {
+		slog.Warn("Failed to hash file", "path", path, "error", err)
+		return
+	}
+	fs.hash = hex.EncodeToString(hasher.Sum(nil))
+}
+
+// isModified returns true if the file at the given path changed since the
+// track was last scanned. Tracks scanned before stats were recorded are
+// assumed to be modified. If hashing is enabled, a file whose size or
+// modification time changed is still unchanged if its content is the same.
+// An empty path disables hashing.
+func (t *Track) isModified(path string, fs *fileStat) bool {
+	if t.Filemtime != 0 && t.Filesize == fs.size && t.Filemtime == fs.mtime {
+		return false
+	}
+
+	if path == "" || t.Filehash == "" {
+		return true
+	}
+	fs.fillHash(path)
+	return fs.hash != t.Filehash
+}
+
+// setFileStat records the given stats, and returns true if they changed.
+func (t *Track) setFileStat(fs fileStat) bool {
+	if t.Filesize == fs.size && t.Filemtime == fs.mtime &&
+		(fs.hash == "" || fs.hash == t.Filehash) {
+		return false
+	}
+
+	t.Filesize, t.Filemtime = fs.size, fs.mtime
+	if fs.hash != "" {
+		t.Filehash = fs.hash
+	}
+	return true
+}
+
 // DeleteDanglingTrack removes a (presumably) non-existent track from collection.
-func DeleteDanglingTrack(t *Track, c *Collection, withRemote bool) (err error) {
+func DeleteDanglingTrack(t *Track, c *Collection, withRemote bool) error {
+	return deleteDanglingTrack(db, t, c, withRemote)
+}
+
+func deleteDanglingTrack(tx *gorm.DB, t *Track, c *Collection,
+	withRemote bool) (err error) {
+
 	if !withRemote && (c.Remote || t.Remote) {
 		return
 	}
 
 	pts := []PlaylistTrack{}
-	err = db.Where("track_id = ?", t.ID).Find(&pts).Error
+	err = tx.Where("track_id = ?", t.ID).Find(&pts).Error
 	if err != nil {
 		return
 	}
 
 	for i := range pts {
 		pl := Playlist{}
-		err = db.Joins("Playbar").First(&pl, pts[i].PlaylistID).Error
+		err = tx.Joins("Playbar").First(&pl, pts[i].PlaylistID).Error
 		if err != nil {
 			return
 		}
 		pl.Playbar.DeleteFromPlaylist(&pl, pts[i].Position)
 	}
-	err = t.Delete()
+	err = t.DeleteTx(tx)
 	return
 }
 
@@ -451,7 +686,7 @@ func DeleteDanglingTrackByID(id int64, withRemote bool) error {
 // DeleteLocalTrackIfDangling deletes the track identified by id if the given
 // location does not exist.
 func DeleteLocalTrackIfDangling(id int64, location string) {
-	path, err := urlstr.URLToPath(location)
+	path, err := urlstr.URLToPath(cuesheet.Source(location))
 	if err != nil {
 		slog.With(
 			"location", location,
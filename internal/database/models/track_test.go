@@ -0,0 +1,107 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/dhowden/tag"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillReplayGain(t *testing.T) {
+	testCases := []struct {
+		name string
+		raw  map[string]interface{}
+		want [4]float64 // track gain & peak, album gain & peak
+	}{
+		{
+			"vorbis comments",
+			map[string]interface{}{
+				"REPLAYGAIN_TRACK_GAIN": "-6.50 dB",
+				"REPLAYGAIN_TRACK_PEAK": "0.988",
+				"REPLAYGAIN_ALBUM_GAIN": "-7.25 dB",
+				"REPLAYGAIN_ALBUM_PEAK": "1.000",
+			},
+			[4]float64{-6.5, 0.988, -7.25, 1},
+		},
+		{
+			"lowercase keys and units",
+			map[string]interface{}{
+				"replaygain_track_gain": " +2.1dB ",
+				"replaygain_track_peak": "0.5",
+			},
+			[4]float64{2.1, 0.5, 0, 0},
+		},
+		{
+			"ID3v2 user text frames",
+			map[string]interface{}{
+				"TXXX":   &tag.Comm{Description: "REPLAYGAIN_TRACK_GAIN", Text: "-3 dB"},
+				"TXXX_0": &tag.Comm{Description: "replaygain_album_peak", Text: "0.75"},
+			},
+			[4]float64{-3, 0, 0, 0.75},
+		},
+		{
+			"missing tags",
+			map[string]interface{}{"TITLE": "some title"},
+			[4]float64{},
+		},
+		{
+			"invalid values are ignored",
+			map[string]interface{}{
+				"REPLAYGAIN_TRACK_GAIN": "loud",
+				"REPLAYGAIN_TRACK_PEAK": 0.9,
+				"REPLAYGAIN_ALBUM_GAIN": "-1 dB",
+			},
+			[4]float64{0, 0, -1, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// previous values are reset
+			tr := &models.Track{
+				Trackgain: 9,
+				Trackpeak: 9,
+				Albumgain: 9,
+				Albumpeak: 9,
+			}
+			tr.FillReplayGain(tc.raw)
+			assert.Equal(t, tc.want,
+				[4]float64{tr.Trackgain, tr.Trackpeak, tr.Albumgain, tr.Albumpeak})
+		})
+	}
+}
+
+func TestPrecedesInAlbum(t *testing.T) {
+	track := func(album, albumartist string, disc, number int) *models.Track {
+		return &models.Track{
+			Album:       album,
+			Albumartist: albumartist,
+			Discnumber:  disc,
+			Tracknumber: number,
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		curr, next *models.Track
+		want       bool
+	}{
+		{"next track", track("album", "artist", 1, 3), track("album", "artist", 1, 4), true},
+		{"same track", track("album", "artist", 1, 3), track("album", "artist", 1, 3), false},
+		{"previous track", track("album", "artist", 1, 3), track("album", "artist", 1, 2), false},
+		{"skipped track", track("album", "artist", 1, 3), track("album", "artist", 1, 5), false},
+		{"first on next disc", track("album", "artist", 1, 12), track("album", "artist", 2, 1), true},
+		{"second on next disc", track("album", "artist", 1, 12), track("album", "artist", 2, 2), false},
+		{"first on later disc", track("album", "artist", 1, 12), track("album", "artist", 3, 1), false},
+		{"another album", track("album", "artist", 1, 3), track("other", "artist", 1, 4), false},
+		{"another album artist", track("album", "artist", 1, 3), track("album", "other", 1, 4), false},
+		{"no album", track("", "artist", 1, 3), track("", "artist", 1, 4), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.curr.PrecedesInAlbum(tc.next))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package seeds
+
+import (
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"gorm.io/gorm"
+)
+
+// SeedEqualizerPreset adds the built-in equalizer presets, unless presets
+// with the same names already exist.
+func SeedEqualizerPreset(tx *gorm.DB) (err error) {
+	create := func(name, description string, bands models.EqualizerBands) error {
+		ep := models.EqualizerPreset{
+			Name:        name,
+			Description: description,
+			Bands:       bands,
+		}
+		return tx.Where(models.EqualizerPreset{Name: name}).
+			Attrs(ep).
+			FirstOrCreate(&models.EqualizerPreset{}).
+			Error
+	}
+
+	list := []struct {
+		name, description string
+		bands             models.EqualizerBands
+	}{
+		{"Flat", "No equalization", models.EqualizerBands{}},
+		{"Bass Boost", "Boosted low frequencies", models.EqualizerBands{6, 5, 4, 2, 0, 0, 0, 0, 0, 0}},
+		{"Classical", "Brighter highs for orchestral music", models.EqualizerBands{0, 0, 0, 0, 0, 0, -2, -2, -2, -4}},
+		{"Jazz", "Warm mids and airy highs", models.EqualizerBands{3, 2, 1, 2, -1, -1, 0, 1, 2, 3}},
+		{"Pop", "Emphasized vocals", models.EqualizerBands{-1, 1, 3, 4, 3, 0, -1, -1, -1, -1}},
+		{"Rock", "Scooped mids", models.EqualizerBands{4, 3, -2, -3, -1, 2, 4, 5, 5, 5}},
+		{"Spoken Word", "Clear speech for audiobooks and podcasts", models.EqualizerBands{-6, -4, -2, 0, 2, 3, 3, 2, 0, -2}},
+	}
+
+	for _, x := range list {
+		if err = create(x.name, x.description, x.bands); err != nil {
+			return
+		}
+	}
+	return
+}
@@ -18,6 +18,10 @@ func All(db *gorm.DB) {
 		Name: "query",
 		Run:  SeedQuery,
 	})
+	h.Add(seater.Seed{
+		Name: "equalizer-preset",
+		Run:  SeedEqualizerPreset,
+	})
 	h.AddSome([]seater.Seed{
 		{
 			Name:     "collection",
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
 )
 
 // M3U  implements the Playlist interface.
@@ -202,5 +203,15 @@ func getURL(s string) (string, error) {
 		u = strings.TrimPrefix(u, "file://")
 	}
 
-	return urlstr.PathToURL(u)
+	// the range of a virtual track is not part of its path
+	source, start, end, ok := cuesheet.SplitLocation(u)
+	if !ok {
+		return urlstr.PathToURL(u)
+	}
+
+	su, err := urlstr.PathToURL(source)
+	if err != nil {
+		return "", err
+	}
+	return cuesheet.Location(su, start, end), nil
 }
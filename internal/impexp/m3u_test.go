@@ -4,6 +4,7 @@ import (
 	"os"
 	"testing"
 
+	"github.com/jwmwalrus/bnp/urlstr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -27,3 +28,13 @@ func TestParseM3U(t *testing.T) {
 	assert.Equal(t, 300, int(dep.tracks[0].Duration/1e9))
 	assert.Equal(t, 300, int(dep.tracks[1].Duration/1e9))
 }
+
+func TestGetURLForVirtualTrack(t *testing.T) {
+	path := "../../data/testing/audio1/track01.ogg"
+	source, err := urlstr.PathToURL(path)
+	assert.NoError(t, err)
+
+	u, err := getURL(path + "#t=1.500,3.000")
+	assert.NoError(t, err)
+	assert.Equal(t, source+"#t=1.500,3.000", u)
+}
@@ -16,8 +16,19 @@ const (
 	RootPath            = "/org/mpris/MediaPlayer2"
 	RootInterface       = "org.mpris.MediaPlayer2"
 	PlayerInterface     = RootInterface + ".Player"
+	TrackListInterface  = RootInterface + ".TrackList"
+	PlaylistsInterface  = RootInterface + ".Playlists"
 	PropertiesInterface = "org.freedesktop.DBus.Properties"
 
+	// TrackPathPrefix is the prefix used for track IDs.
+	TrackPathPrefix = "/com/github/jwmwalrus/m3uetcetera/track"
+
+	// PlaylistPathPrefix is the prefix used for playlist IDs.
+	PlaylistPathPrefix = "/com/github/jwmwalrus/m3uetcetera/playlist"
+
+	// NoTrack is the track ID used when there is no current track.
+	NoTrack = RootPath + "/TrackList/NoTrack"
+
 	name       = "M3UEtcetera"
 	serverName = RootInterface + "." + name
 )
@@ -52,8 +63,28 @@ func (i *Instance) Delete() error {
 	return nil
 }
 
-// Setup sets the player.
-func (i *Instance) Setup(p Player) (err error) {
+// SetPlayerProperty updates the given player property and emits the
+// corresponding change.
+func (i *Instance) SetPlayerProperty(name string, value any) {
+	props := i.props.Load()
+	if props == nil {
+		return
+	}
+	props.SetMust(PlayerInterface, name, value)
+}
+
+// EmitSeeked emits the player's Seeked signal, with the new position
+// in microseconds.
+func (i *Instance) EmitSeeked(position int64) {
+	conn := i.Conn.Load()
+	if conn == nil {
+		return
+	}
+	onerror.Warn(conn.Emit(RootPath, PlayerInterface+".Seeked", position))
+}
+
+// Setup sets the player, track list and playlists.
+func (i *Instance) Setup(p Player, tl TrackList, pls Playlists) (err error) {
 	mp2 := &MediaPlayer2{i}
 	err = i.Conn.Load().Export(mp2, RootPath, RootInterface)
 	if err != nil {
@@ -65,6 +96,16 @@ func (i *Instance) Setup(p Player) (err error) {
 		return
 	}
 
+	err = i.Conn.Load().Export(tl, RootPath, TrackListInterface)
+	if err != nil {
+		return
+	}
+
+	err = i.Conn.Load().Export(pls, RootPath, PlaylistsInterface)
+	if err != nil {
+		return
+	}
+
 	err = i.Conn.Load().Export(
 		introspect.NewIntrospectable(&introspect.Node{
 			Name: serverName,
@@ -72,6 +113,8 @@ func (i *Instance) Setup(p Player) (err error) {
 				i.introspectInterface(),
 				mp2.introspectInterface(),
 				p.IntrospectInterface(),
+				tl.IntrospectInterface(),
+				pls.IntrospectInterface(),
 			},
 		}),
 		RootPath,
@@ -82,8 +125,10 @@ func (i *Instance) Setup(p Player) (err error) {
 	}
 
 	props, err := prop.Export(i.Conn.Load(), RootPath, map[string]map[string]*prop.Prop{
-		RootInterface:   mp2.properties(),
-		PlayerInterface: p.Properties(),
+		RootInterface:      mp2.properties(),
+		PlayerInterface:    p.Properties(),
+		TrackListInterface: tl.Properties(),
+		PlaylistsInterface: pls.Properties(),
 	})
 	i.props.Store(props)
 	if err != nil {
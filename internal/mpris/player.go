@@ -18,7 +18,7 @@ type Player interface {
 	Stop() *dbus.Error
 	Play() *dbus.Error
 	Seek(x int64) *dbus.Error
-	SetPosition(o string, x int64) *dbus.Error
+	SetPosition(o dbus.ObjectPath, x int64) *dbus.Error
 	OpenUri(s string) *dbus.Error
 
 	// Seeked(x int64) *dbus.Error
@@ -0,0 +1,102 @@
+package mpris
+
+import (
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+	"github.com/jwmwalrus/bnp/onerror"
+)
+
+// Playlist orderings.
+const (
+	OrderingAlphabetical = "Alphabetical"
+	OrderingCreationDate = "CreationDate"
+	OrderingModifiedDate = "ModifiedDate"
+	OrderingLastPlayDate = "LastPlayDate"
+	OrderingUserDefined  = "UserDefined"
+)
+
+// Playlist defines a playlist, i.e., the (oss) structure.
+type Playlist struct {
+	ID   dbus.ObjectPath
+	Name string
+	Icon string // URI, or empty
+}
+
+// MaybePlaylist defines the active playlist, i.e., the (b(oss))
+// structure. Valid is false if there is no active playlist.
+type MaybePlaylist struct {
+	Valid    bool
+	Playlist Playlist
+}
+
+// Playlists -.
+type Playlists interface {
+	IntrospectInterface() introspect.Interface
+	Properties() map[string]*prop.Prop
+
+	ActivatePlaylist(id dbus.ObjectPath) *dbus.Error
+	GetPlaylists(index, maxCount uint32, order string, reverseOrder bool) ([]Playlist, *dbus.Error)
+
+	PlaylistCount() uint32
+	Orderings() []string
+	ActivePlaylist() MaybePlaylist
+}
+
+// PlaylistsIntrospectInterface returns the instrospection for the
+// playlists.
+func PlaylistsIntrospectInterface() introspect.Interface {
+	return introspect.Interface{
+		Name: PlaylistsInterface,
+		Properties: []introspect.Property{
+			{Name: "PlaylistCount", Type: "u", Access: "read"},
+			{Name: "Orderings", Type: "as", Access: "read"},
+			{Name: "ActivePlaylist", Type: "(b(oss))", Access: "read"},
+		},
+		Signals: []introspect.Signal{
+			{
+				Name: "PlaylistChanged",
+				Args: []introspect.Arg{
+					{Name: "Playlist", Type: "(oss)"},
+				},
+			},
+		},
+		Methods: []introspect.Method{
+			{
+				Name: "ActivatePlaylist",
+				Args: []introspect.Arg{
+					{Name: "PlaylistId", Type: "o", Direction: "in"},
+				},
+			},
+			{
+				Name: "GetPlaylists",
+				Args: []introspect.Arg{
+					{Name: "Index", Type: "u", Direction: "in"},
+					{Name: "MaxCount", Type: "u", Direction: "in"},
+					{Name: "Order", Type: "s", Direction: "in"},
+					{Name: "ReverseOrder", Type: "b", Direction: "in"},
+					{Name: "Playlists", Type: "a(oss)", Direction: "out"},
+				},
+			},
+		},
+	}
+}
+
+// SetPlaylistsProperty updates the given playlists property and emits the
+// corresponding change.
+func (i *Instance) SetPlaylistsProperty(name string, value any) {
+	props := i.props.Load()
+	if props == nil {
+		return
+	}
+	props.SetMust(PlaylistsInterface, name, value)
+}
+
+// EmitPlaylistChanged emits the playlists' PlaylistChanged signal.
+func (i *Instance) EmitPlaylistChanged(pl Playlist) {
+	conn := i.Conn.Load()
+	if conn == nil {
+		return
+	}
+	onerror.Warn(conn.Emit(RootPath, PlaylistsInterface+".PlaylistChanged", pl))
+}
@@ -1,12 +1,22 @@
 package mpris
 
 import (
+	"fmt"
+	"log/slog"
+	"os/exec"
+
 	"github.com/godbus/dbus/v5"
 	"github.com/godbus/dbus/v5/introspect"
 	"github.com/godbus/dbus/v5/prop"
+	"github.com/jwmwalrus/bnp/env"
+	"github.com/jwmwalrus/m3u-etcetera/internal/alive"
 	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	rtc "github.com/jwmwalrus/rtcycler"
 )
 
+// guiBin is the GUI launched by Raise, also used as desktop entry.
+const guiBin = "m3uetc-gtk"
+
 // MediaPlayer2 implements the org.mpris.MediaPlayer2 root interface.
 type MediaPlayer2 struct {
 	*Instance
@@ -47,12 +57,38 @@ func (mp2 *MediaPlayer2) properties() map[string]*prop.Prop {
 	}
 }
 
+// Quit turns the server off, the same way RootSvc.Off does.
 func (*MediaPlayer2) Quit() *dbus.Error {
-	// TODO: implement
+	alive.TurnOff(true)
 	return nil
 }
 
+// Raise launches the GUI. If it is already running, GTK activates the
+// running instance instead, bringing its window up.
 func (*MediaPlayer2) Raise() *dbus.Error {
+	full, err := exec.LookPath(guiBin)
+	if err != nil {
+		if full = env.FindExec(guiBin); full == "" {
+			return dbus.MakeFailedError(
+				fmt.Errorf("failed to find binary `%s` for GUI", guiBin),
+			)
+		}
+	}
+
+	args := []string{}
+	if rtc.FlagTestMode() {
+		args = append(args, "--test")
+	}
+
+	cmd := exec.Command(full, args...)
+	if err := cmd.Start(); err != nil {
+		return dbus.MakeFailedError(err)
+	}
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			slog.Warn("GUI exited with an error", "error", err)
+		}
+	}()
 	return nil
 }
 
@@ -69,11 +105,11 @@ func (*MediaPlayer2) CanSetFullscreen() bool {
 }
 
 func (*MediaPlayer2) CanRaise() bool {
-	return false
+	return true
 }
 
 func (*MediaPlayer2) HasTrackList() bool {
-	return false
+	return true
 }
 
 func (*MediaPlayer2) Identity() string {
@@ -81,7 +117,7 @@ func (*MediaPlayer2) Identity() string {
 }
 
 func (*MediaPlayer2) DesktopEntry() string {
-	return "m3uetc-server"
+	return guiBin
 }
 
 func (*MediaPlayer2) SupportedUriSchemes() []string {
@@ -0,0 +1,125 @@
+package mpris
+
+import (
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+	"github.com/jwmwalrus/bnp/onerror"
+)
+
+// TrackList -.
+type TrackList interface {
+	IntrospectInterface() introspect.Interface
+	Properties() map[string]*prop.Prop
+
+	GetTracksMetadata(ids []dbus.ObjectPath) ([]map[string]dbus.Variant, *dbus.Error)
+	AddTrack(uri string, after dbus.ObjectPath, setAsCurrent bool) *dbus.Error
+	RemoveTrack(id dbus.ObjectPath) *dbus.Error
+	GoTo(id dbus.ObjectPath) *dbus.Error
+
+	Tracks() []dbus.ObjectPath
+	CanEditTracks() bool
+}
+
+// TrackListIntrospectInterface returns the instrospection for the track
+// list.
+func TrackListIntrospectInterface() introspect.Interface {
+	return introspect.Interface{
+		Name: TrackListInterface,
+		Properties: []introspect.Property{
+			{Name: "Tracks", Type: "ao", Access: "read"},
+			{Name: "CanEditTracks", Type: "b", Access: "read"},
+		},
+		Signals: []introspect.Signal{
+			{
+				Name: "TrackListReplaced",
+				Args: []introspect.Arg{
+					{Name: "Tracks", Type: "ao"},
+					{Name: "CurrentTrack", Type: "o"},
+				},
+			},
+			{
+				Name: "TrackAdded",
+				Args: []introspect.Arg{
+					{Name: "Metadata", Type: "a{sv}"},
+					{Name: "AfterTrack", Type: "o"},
+				},
+			},
+			{
+				Name: "TrackRemoved",
+				Args: []introspect.Arg{
+					{Name: "TrackId", Type: "o"},
+				},
+			},
+			{
+				Name: "TrackMetadataChanged",
+				Args: []introspect.Arg{
+					{Name: "TrackId", Type: "o"},
+					{Name: "Metadata", Type: "a{sv}"},
+				},
+			},
+		},
+		Methods: []introspect.Method{
+			{
+				Name: "GetTracksMetadata",
+				Args: []introspect.Arg{
+					{Name: "TrackIds", Type: "ao", Direction: "in"},
+					{Name: "Metadata", Type: "aa{sv}", Direction: "out"},
+				},
+			},
+			{
+				Name: "AddTrack",
+				Args: []introspect.Arg{
+					{Name: "Uri", Type: "s", Direction: "in"},
+					{Name: "AfterTrack", Type: "o", Direction: "in"},
+					{Name: "SetAsCurrent", Type: "b", Direction: "in"},
+				},
+			},
+			{
+				Name: "RemoveTrack",
+				Args: []introspect.Arg{
+					{Name: "TrackId", Type: "o", Direction: "in"},
+				},
+			},
+			{
+				Name: "GoTo",
+				Args: []introspect.Arg{
+					{Name: "TrackId", Type: "o", Direction: "in"},
+				},
+			},
+		},
+	}
+}
+
+// SetTrackListProperty updates the given track list property and emits
+// the corresponding change.
+func (i *Instance) SetTrackListProperty(name string, value any) {
+	props := i.props.Load()
+	if props == nil {
+		return
+	}
+	props.SetMust(TrackListInterface, name, value)
+}
+
+// EmitTrackListReplaced emits the track list's TrackListReplaced signal.
+func (i *Instance) EmitTrackListReplaced(tracks []dbus.ObjectPath, current dbus.ObjectPath) {
+	i.emitTrackList("TrackListReplaced", tracks, current)
+}
+
+// EmitTrackAdded emits the track list's TrackAdded signal.
+func (i *Instance) EmitTrackAdded(metadata map[string]dbus.Variant, after dbus.ObjectPath) {
+	i.emitTrackList("TrackAdded", metadata, after)
+}
+
+// EmitTrackRemoved emits the track list's TrackRemoved signal.
+func (i *Instance) EmitTrackRemoved(id dbus.ObjectPath) {
+	i.emitTrackList("TrackRemoved", id)
+}
+
+func (i *Instance) emitTrackList(signal string, values ...any) {
+	conn := i.Conn.Load()
+	if conn == nil {
+		return
+	}
+	onerror.Warn(conn.Emit(RootPath, TrackListInterface+"."+signal, values...))
+}
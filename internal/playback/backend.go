@@ -0,0 +1,222 @@
+package playback
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/discover"
+)
+
+// State defines the state of a pipeline. The values match GStreamer's.
+type State int
+
+// State enum.
+const (
+	StateVoidPending State = iota
+	StateNull
+	StateReady
+	StatePaused
+	StatePlaying
+)
+
+func (s State) String() string {
+	return []string{
+		"void-pending",
+		"null",
+		"ready",
+		"paused",
+		"playing",
+	}[s]
+}
+
+// MessageType defines the type of a message posted by a pipeline.
+type MessageType int
+
+// MessageType enum.
+const (
+	MessageUnknown MessageType = iota
+	MessageEOS
+	MessageError
+	MessageWarning
+	MessageInfo
+	MessageStateChanged
+	MessageStreamStart
+	MessageDurationChanged
+	MessageTag
+	MessageBuffering
+	MessageAboutToFinish
+)
+
+// Message defines a message posted by a pipeline while it runs.
+type Message struct {
+	Type      MessageType
+	Text      string  // for logging
+	Err       error   // for MessageError
+	PrevState State   // for MessageStateChanged
+	State     State   // for MessageStateChanged
+	Percent   int     // for MessageBuffering
+	Tags      TagList // for MessageTag
+}
+
+// Tags reported by remote streams.
+const (
+	TagTitle          = "title"
+	TagArtist         = "artist"
+	TagOrganization   = "organization"
+	TagBitrate        = "bitrate"
+	TagNominalBitrate = "nominal-bitrate"
+)
+
+// TagList defines the tags reported by a stream.
+type TagList interface {
+	GetString(tag string) (string, bool)
+	GetUint32(tag string) (uint32, bool)
+}
+
+// Pipeline defines a backend's player, which plays one stream at a time.
+type Pipeline interface {
+	// SetLocation sets the stream to play. While a stream is running, the
+	// given one is queued to follow it without a gap.
+	SetLocation(location string) error
+
+	// SetState changes the state of the pipeline.
+	SetState(state State) error
+
+	// Position returns the position of the running stream.
+	Position() (int64, bool)
+
+	// Duration returns the duration of the running stream.
+	Duration() (int64, bool)
+
+	// Seekable returns true if the running stream can be seeked. The
+	// second value is false if the stream could not tell.
+	Seekable() (seekable, ok bool)
+
+	// SeekTo moves the running stream to the given position, at the given
+	// rate. An accurate seek does not snap to the nearest key unit.
+	SeekTo(position int64, rate float64, accurate bool) bool
+
+	// SetVolume sets the volume, in the [0, 1] range.
+	SetVolume(volume float64) error
+
+	// SetMute mutes or unmutes the pipeline.
+	SetMute(mute bool) error
+
+	// SetGain sets the ReplayGain factor.
+	SetGain(factor float64) error
+
+	// SetEqualizer sets the equalizer's bands, if equalization is
+	// available.
+	SetEqualizer(bands models.EqualizerBands) error
+
+	// SetOutput routes the pipeline to the given output. A running stream
+	// keeps its position and state, but a queued one is dropped.
+	SetOutput(od OutputDevice) error
+
+	// Run posts the pipeline's messages to its handler, until Quit is
+	// called.
+	Run()
+
+	// Quit makes Run return. The pipeline keeps its state.
+	Quit()
+}
+
+// Backend defines a playback backend, which provides the pipelines, the
+// outputs and the broadcast.
+type Backend interface {
+	// NewPipeline returns a new pipeline, which posts its messages to
+	// the given handler.
+	NewPipeline(handle func(Message)) (Pipeline, error)
+
+	// Discover finds out whether the given stream is seekable or live,
+	// and its duration.
+	Discover(location string) (*discover.Info, error)
+
+	// HasOutputSink returns true if the given sink is available.
+	HasOutputSink(sink string) bool
+
+	// ListOutputDevices returns the available outputs, besides the ones
+	// that are always available.
+	ListOutputDevices() []OutputDevice
+
+	// StartBroadcast starts serving the playback over HTTP.
+	StartBroadcast(format string, bitrate int) error
+
+	// StopBroadcast stops serving the playback over HTTP.
+	StopBroadcast()
+
+	// BroadcastStatus returns the state of the broadcast output.
+	BroadcastStatus() BroadcastStatus
+
+	// SetBroadcastTitle sets the broadcast's now-playing title.
+	SetBroadcastTitle(title string)
+}
+
+// BackendFactory returns a new backend.
+type BackendFactory func() (Backend, error)
+
+var (
+	backendsMu sync.Mutex
+	backends   = map[string]BackendFactory{}
+)
+
+// RegisterBackend makes a backend available under the given name.
+func RegisterBackend(name string, f BackendFactory) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+	backends[name] = f
+}
+
+// newBackend returns the backend registered under the given name.
+func newBackend(name string) (Backend, error) {
+	backendsMu.Lock()
+	f, ok := backends[name]
+	names := make([]string, 0, len(backends))
+	for k := range backends {
+		names = append(names, k)
+	}
+	backendsMu.Unlock()
+
+	if !ok {
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown playback backend: %v (available: %v)", name, names)
+	}
+	return f()
+}
+
+// pipelineSlot holds a pipeline, so it can be swapped safely.
+type pipelineSlot struct {
+	mu sync.RWMutex
+	p  Pipeline
+}
+
+func (ps *pipelineSlot) Load() Pipeline {
+	ps.mu.RLock()
+	defer ps.mu.RUnlock()
+	return ps.p
+}
+
+func (ps *pipelineSlot) Store(p Pipeline) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	ps.p = p
+}
+
+func (ps *pipelineSlot) Swap(p Pipeline) (old Pipeline) {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	old, ps.p = ps.p, p
+	return
+}
+
+func (ps *pipelineSlot) CompareAndSwap(old, p Pipeline) bool {
+	ps.mu.Lock()
+	defer ps.mu.Unlock()
+	if ps.p != old {
+		return false
+	}
+	ps.p = p
+	return true
+}
@@ -0,0 +1,38 @@
+package playback
+
+import (
+	"path/filepath"
+)
+
+// BroadcastStatus defines the state of the broadcast output.
+type BroadcastStatus struct {
+	Active    bool
+	Format    string // opus or mp3
+	Bitrate   int    // in kbps
+	URL       string
+	Listeners int
+	Title     string
+}
+
+// updateBroadcastTitle sets the broadcast's now-playing title from the
+// current playback.
+func (e *engine) updateBroadcastTitle() {
+	if !e.backend.BroadcastStatus().Active {
+		return
+	}
+
+	title := ""
+	if pb, t := instance.GetPlayback(); t != nil {
+		switch {
+		case t.Artist != "" && t.Title != "":
+			title = t.Artist + " - " + t.Title
+		case t.Title != "":
+			title = t.Title
+		default:
+			title = filepath.Base(pb.Location)
+		}
+	} else if pb != nil {
+		title = filepath.Base(pb.Location)
+	}
+	e.backend.SetBroadcastTitle(title)
+}
@@ -3,25 +3,21 @@ package playback
 import (
 	"context"
 	"fmt"
+	"io/fs"
 	"log/slog"
-	"strconv"
+	"math"
 	"sync/atomic"
 	"time"
 
 	"github.com/godbus/dbus/v5"
 	"github.com/jwmwalrus/bnp/onerror"
 	"github.com/jwmwalrus/gear-pieces/idler"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
 	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
 	"github.com/jwmwalrus/m3u-etcetera/internal/mpris"
+	"github.com/jwmwalrus/m3u-etcetera/internal/scrobble"
 	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
 	rtc "github.com/jwmwalrus/rtcycler"
-
-	"github.com/go-gst/go-glib/glib"
-	"github.com/go-gst/go-gst/gst"
-)
-
-const (
-	positionThreshold = 1e9
 )
 
 var (
@@ -39,33 +35,65 @@ const (
 	hintStartPlaylist
 	hintNextInPlaylist
 	hintPrevInPlaylist
+	hintCrossfade
+	hintRepeatTrack
 )
 
-type gstState struct{ atomic.Int32 }
+type pipelineState struct{ atomic.Int32 }
 
-func (gs *gstState) Load() gst.State   { return gst.State(gs.Int32.Load()) }
-func (gs *gstState) Store(s gst.State) { gs.Int32.Store(int32(s)) }
+func (ps *pipelineState) Load() State   { return State(ps.Int32.Load()) }
+func (ps *pipelineState) Store(s State) { ps.Int32.Store(int32(s)) }
+
+// nextStream defines a stream handed to the running pipeline ahead of time.
+type nextStream struct {
+	pb *models.Playback
+
+	// prevPt is the playlist track before advancing in the playlist, if the
+	// stream was obtained from the active playlist.
+	prevPt *models.PlaylistTrack
+}
 
 type engine struct {
 	freezePlayback atomic.Bool
 	terminate      atomic.Bool
 	seekable       atomic.Bool
 	seekableDone   atomic.Bool
+	canSeek        atomic.Bool // as reported by discover
+	crossfade      atomic.Bool
+	crossfadeDone  atomic.Bool
+	live           atomic.Bool // as reported by discover
+	fromBeginning  atomic.Bool // ignore the resume position once
+	startPaused    atomic.Bool // start the next stream paused, once
+	mute           atomic.Bool
 	lastPosition   atomic.Int64
 	duration       atomic.Int64
+	fadeIn         atomic.Int64
 	buffering      atomic.Int32
+	hint           atomic.Int32  // playbackHint
+	rateIdx        atomic.Int32  // perspective the rate was chosen for
+	rate           atomic.Uint64 // float64 bits
+	volume         atomic.Uint64 // float64 bits
 	pt             atomic.Pointer[models.PlaylistTrack]
 	pb             atomic.Pointer[models.Playback]
 	t              atomic.Pointer[models.Track]
-	playbin        atomic.Pointer[gst.Element]
+	next           atomic.Pointer[nextStream]
+	fadeInPb       atomic.Pointer[models.Playback]
+	repeatPb       atomic.Pointer[models.Playback]
+	shuffle        atomic.Pointer[shuffleOrder]
+	streamInfo     atomic.Pointer[StreamInfo]
+	sleep          atomic.Pointer[sleepTimer]
+	pipeline       pipelineSlot
+	fading         pipelineSlot
 	lastEvent      engineEvent
-	prevState      gstState
-	state          gstState
-
-	mpris *Player
-
-	mainLoop *glib.MainLoop
-	hint     playbackHint
+	prevState      pipelineState
+	state          pipelineState
+
+	backend        Backend
+	mpris          *Player
+	mprisTracks    *TrackList
+	mprisPlaylists *Playlists
+	mprisQuit      chan struct{} // stops watching the stores
+	mprisOff       bool          // do not export the MPRIS interface (e.g., in tests)
 }
 
 func init() {
@@ -131,18 +159,24 @@ loop:
 			case hintNextInPlaylist:
 				pb = e.getNextInPlaylist(false)
 				break signals
+			case hintCrossfade:
+				if fpb := e.fadeInPb.Swap(nil); fpb != nil {
+					pb = fpb
+					break signals
+				}
+				err = pb.GetNextToPlay()
+			case hintRepeatTrack:
+				if rpb := e.repeatPb.Swap(nil); rpb != nil {
+					pb = repeatStream(rpb)
+					break signals
+				}
+				err = pb.GetNextToPlay()
 			default:
 				err = pb.GetNextToPlay()
 			}
 
 			if err != nil {
-				q, _ := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
-				if qt := q.Pop(); qt != nil {
-					slog.Debug("Popped successfully")
-					pb = e.addPlaybackFromQueue(qt)
-				} else if e.pt.Load() != nil {
-					pb = e.getNextInPlaylist(false)
-				}
+				pb = e.getNextInQueueOrPlaylist()
 			}
 			break signals
 		}
@@ -166,6 +200,37 @@ loop:
 	slog.Info("Firing event", "event", e.lastEvent)
 }
 
+// fetchNextStream obtains the stream after the current one, from
+// playback, queue or the active playlist.
+func (e *engine) fetchNextStream() (ns *nextStream) {
+	curr := e.pb.Load()
+	if curr == nil {
+		return
+	}
+
+	slog.Info("Obtaining next stream ahead of time", "pb", *curr)
+
+	prevPt := e.pt.Load()
+
+	pb := &models.Playback{}
+	if e.getRepeatMode() == models.RepeatTrack {
+		pb = repeatStream(curr)
+	} else if err := pb.GetNextToPlay(curr.ID); err != nil {
+		pb = e.getNextInQueueOrPlaylist()
+	}
+
+	if pb == nil || pb.ID == 0 || pb.Location == "" {
+		slog.Debug("There is no next stream")
+		return
+	}
+
+	ns = &nextStream{pb: pb}
+	if e.pt.Load() != prevPt {
+		ns.prevPt = prevPt
+	}
+	return
+}
+
 func (e *engine) getFirstInPlaylist() (pb *models.Playback) {
 	if e.pt.Load() == nil {
 		slog.Error("There is no playlist-track available")
@@ -194,7 +259,7 @@ func (e *engine) getNextInPlaylist(goingBack bool) (pb *models.Playback) {
 	logw := slog.With("pt", *e.pt.Load())
 	logw.Info("Obtaining next track in playlist")
 
-	newpt, err := e.pt.Load().GetTrackAfter(goingBack)
+	newpt, err := e.getTrackAfter(e.pt.Load(), goingBack)
 	if err != nil {
 		pl := e.pt.Load().Playlist
 		if pl.ID == 0 {
@@ -217,17 +282,32 @@ func (e *engine) getNextInPlaylist(goingBack bool) (pb *models.Playback) {
 	return
 }
 
-func (e *engine) getPlaybackHint(keep ...bool) (h playbackHint) {
-	h = e.hint
+func (e *engine) getNextInQueueOrPlaylist() (pb *models.Playback) {
+	q, _ := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
+	if qt := q.Pop(); qt != nil {
+		slog.Debug("Popped successfully")
+		pb = e.addPlaybackFromQueue(qt)
+	} else if e.pt.Load() != nil {
+		pb = e.getNextInPlaylist(false)
+	}
+	return
+}
 
-	reset := true
-	if len(keep) > 0 {
-		reset = !keep[0]
+// getRepeatMode returns the repeat mode of the active perspective.
+func (e *engine) getRepeatMode() models.RepeatMode {
+	bar, err := models.GetActivePerspectiveIndex().GetPlaybar()
+	if err != nil {
+		slog.Error("Failed to get playbar for active perspective", "error", err)
+		return models.RepeatNone
 	}
-	if reset {
-		e.hint = hintNone
+	return bar.Repeat
+}
+
+func (e *engine) getPlaybackHint(keep ...bool) playbackHint {
+	if len(keep) > 0 && keep[0] {
+		return playbackHint(e.hint.Load())
 	}
-	return
+	return playbackHint(e.hint.Swap(int32(hintNone)))
 }
 
 func (e *engine) getPrevInHistory() (pb *models.Playback, err error) {
@@ -255,24 +335,37 @@ func (e *engine) getPrevInHistory() (pb *models.Playback, err error) {
 	return
 }
 
-func (e *engine) handleBusMessage(msg *gst.Message) bool {
-	switch msg.Type() {
-	case gst.MessageEOS:
+func (e *engine) handleMessage(pl Pipeline, msg Message) {
+	switch msg.Type {
+	case MessageEOS:
 		slog.Debug("End of stream", "location", e.pb.Load().Location)
+		if e.sleepAtEndOfStream() {
+			broadcastToSubscribers(subscription.ToPlaybackEvent)
+		} else if e.getPlaybackHint(true) == hintNone &&
+			e.getRepeatMode() == models.RepeatTrack {
+			e.repeatPb.Store(e.pb.Load())
+			e.setPlaybackHint(hintRepeatTrack)
+		}
 		e.wrapUp()
-		e.mainLoop.Quit()
-	case gst.MessageError:
-		slog.Error(msg.String())
-		e.wrapUp()
-		e.mainLoop.Quit()
-	case gst.MessageWarning:
-		slog.Warn(msg.String())
-	case gst.MessageInfo:
-		rtc.Trace(msg.String())
-	case gst.MessageStateChanged:
-		prevState, state := msg.ParseStateChanged()
-		e.prevState.Store(prevState)
-		e.state.Store(state)
+		pl.Quit()
+	case MessageError:
+		slog.Error(msg.Text)
+		pb := e.pb.Load()
+		e.failStream(pb, msg.Err)
+		if e.lastPosition.Load() > 0 {
+			e.wrapUp()
+		} else {
+			pb.Blacklist()
+			e.terminate.Store(true)
+		}
+		pl.Quit()
+	case MessageWarning:
+		slog.Warn(msg.Text)
+	case MessageInfo:
+		rtc.Trace(msg.Text)
+	case MessageStateChanged:
+		e.prevState.Store(msg.PrevState)
+		e.state.Store(msg.State)
 		slog.With(
 			"previousState", e.prevState.Load(),
 			"newState", e.state.Load(),
@@ -280,31 +373,42 @@ func (e *engine) handleBusMessage(msg *gst.Message) bool {
 			Debug("Pipeline state changed")
 
 		e.updateMPRIS(false)
-	case gst.MessageDurationChanged:
+	case MessageStreamStart:
+		e.startNextStream()
+	case MessageDurationChanged:
 		e.duration.Store(0)
-	case gst.MessageBuffering:
-		e.buffering.Store(int32(msg.ParseBuffering()))
+	case MessageTag:
+		e.handleTags(msg.Tags)
+	case MessageBuffering:
+		e.buffering.Store(int32(msg.Percent))
 		if e.buffering.Load() < 100 {
-			e.state.Store(gst.StatePaused)
+			e.state.Store(StatePaused)
 		} else {
-			e.state.Store(gst.StatePlaying)
+			e.state.Store(StatePlaying)
+		}
+		onerror.Log(pl.SetState(e.state.Load()))
+	case MessageAboutToFinish:
+		// a pipeline being faded out may still post this message
+		if e.pipeline.Load() == pl {
+			e.queueNextStream()
 		}
-		onerror.Log(e.playbin.Load().SetState(e.state.Load()))
 	default:
-		rtc.Trace(msg.String())
+		rtc.Trace(msg.Text)
 	}
-
-	return true
 }
 
 func (e *engine) playStream(pb *models.Playback) {
 	logw := slog.With("pb", *pb)
 	logw.Info("Starting playStream")
 
+	e.applyResumePosition(pb)
 	e.pb.Store(pb)
+	e.buffering.Store(100)
 	e.terminate.Store(false)
 	defer e.reset()
 
+	e.discoverSeekable(pb)
+
 	idler.GetBusy(idler.StatusEngineLoop)
 	defer func() { idler.GetFree(idler.StatusEngineLoop) }()
 
@@ -312,79 +416,88 @@ func (e *engine) playStream(pb *models.Playback) {
 	if e.pb.Load() == nil || pb.Location == "" {
 		return
 	}
+	if !isRemoteLocation(pb.Location) && isMissingFile(pb.Location) {
+		logw.Error("Playback location does not exist")
+		e.failStream(pb, fs.ErrNotExist)
+		pb.Blacklist()
+		return
+	}
 	logw.Debug("Playback is valid")
 
-	var err error
-
-	e.mainLoop = glib.NewMainLoop(glib.MainContextDefault(), false)
-
-	playbin, err := gst.NewElementWithName("playbin", "m3uetc-playbin")
-	e.playbin.Store(playbin)
+	var pl Pipeline
+	pl, err := e.backend.NewPipeline(func(msg Message) {
+		e.handleMessage(pl, msg)
+	})
 	if err != nil {
-		logw.Error("Failed to create playbin", "error", err)
+		logw.Error("Failed to create pipeline", "error", err)
 		return
 	}
-	logw.Debug("Playbin created")
+	e.pipeline.Store(pl)
+	logw.Debug("Pipeline created")
 
-	if e.playbin.Load() == nil {
-		logw.Error("Not all elements could be created")
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	e.updateMPRIS(false)
+	go e.updateBroadcastTitle()
+	go e.updateNowPlaying()
+
+	if err := pl.SetLocation(pb.Location); err != nil {
+		logw.Error("Unable to set location", "error", err)
+		e.failStream(pb, err)
+		pb.Blacklist()
 		return
 	}
 
-	flags, err := e.playbin.Load().GetProperty("flags")
-	if err != nil {
-		logw.Error("Unable to get flags", "error", err)
-	} else {
-		eflags := flags.(uint)
-		eflags = eflags &^ (1 << 0) // no video
-		eflags = eflags | (1 << 1)  // yes audio
-		eflags = eflags &^ (1 << 2) // no text
-		e.playbin.Load().SetArg("flags", strconv.FormatInt(int64(eflags), 10))
-		fflags, _ := e.playbin.Load().GetProperty("flags")
-		if fflags.(uint) != eflags {
-			logw.With(
-				"initialFlags", flags,
-				"expectedFlags", eflags,
-				"finalFlags", fflags,
-			).Warn("Flags could not be set")
-		}
+	e.applyReplayGain(pb)
+	e.applyEqualizer(pb)
+
+	if err := pl.SetOutput(getOutputDevice()); err != nil {
+		logw.Warn("Unable to set output", "error", err)
 	}
 
-	broadcastToSubscribers(subscription.ToPlaybackEvent)
-	e.updateMPRIS(false)
+	e.setPerspectiveRate()
 
-	e.playbin.Load().Set("uri", pb.Location)
+	onerror.Log(pl.SetMute(e.mute.Load()))
 
-	bus := e.playbin.Load().GetBus()
+	fadeIn := time.Duration(e.fadeIn.Swap(0))
+	if fadeIn > 0 {
+		onerror.Log(pl.SetVolume(0))
+	} else {
+		onerror.Log(pl.SetVolume(e.getVolume()))
+	}
 
-	e.state.Store(gst.StatePlaying)
-	if err := e.playbin.Load().SetState(e.state.Load()); err != nil {
+	state := StatePlaying
+	if e.startPaused.Swap(false) {
+		state = StatePaused
+	}
+	e.state.Store(state)
+	if err := pl.SetState(e.state.Load()); err != nil {
 		logw.Error("Unable to start playback", "error", err)
+		e.failStream(pb, err)
 		pb.Blacklist()
 		return
 	}
-	logw.Debug("State changed", "state", gst.StatePlaying)
+	logw.Debug("State changed", "state", state)
+
+	if fadeIn > 0 {
+		go e.fadeVolume(pl, 0, 1, fadeIn, nil)
+	}
 
 	pqctx, cancelpq := context.WithCancel(context.Background())
 	go e.performQueries(pqctx)
 
-	bus.AddWatch(func(msg *gst.Message) bool {
-		return e.handleBusMessage(msg)
-	})
-
-	e.mainLoop.Run()
-
-	bus.RemoveWatch()
+	pl.Run()
 
 	cancelpq()
 
 	logw.Debug("End of playback")
-	e.state.Store(gst.StateNull)
-	e.playbin.Load().SetState(e.state.Load())
+	e.state.Store(StateNull)
+	if e.fading.Load() != pl {
+		onerror.Log(pl.SetState(e.state.Load()))
+	}
 }
 
 func (e *engine) performQueries(ctx context.Context) {
-	tick := time.NewTicker(time.Duration(positionThreshold) * time.Nanosecond)
+	tick := time.NewTicker(progressInterval())
 	defer tick.Stop()
 
 	for {
@@ -396,26 +509,49 @@ func (e *engine) performQueries(ctx context.Context) {
 				break
 			}
 
-			if e.state.Load() != gst.StatePlaying {
+			pl := e.pipeline.Load()
+			if pl == nil {
+				continue
+			}
+
+			if e.state.Load() != StatePlaying {
+				// keep subscribers posted while the stream buffers
+				if e.buffering.Load() < 100 {
+					broadcastToSubscribers(subscription.ToPlaybackEvent)
+				}
 				continue
 			}
 
 			// Query the current position of the stream
-			var position int64
-			var ok bool
-			if ok, position = e.playbin.Load().QueryPosition(gst.FormatTime); !ok {
+			position, ok := pl.Position()
+			if !ok {
 				slog.Warn("Could not query current position")
 			}
 
 			if position > 0 {
 				broadcastToSubscribers(subscription.ToPlaybackEvent)
 				e.lastPosition.Store(position)
+				if m := e.mpris; m != nil {
+					go m.SetPlayerProperty("Position", position/int64(time.Microsecond))
+				}
+			}
+
+			e.fadeOutBeforeSleep(position)
+
+			if e.crossfade.Load() && !e.crossfadeDone.Load() {
+				d := int64(crossfadeDuration())
+				duration := e.duration.Load()
+				left := int64(float64(duration-position) / e.getRate())
+				if duration > 2*d && left <= d {
+					e.startCrossfade()
+					continue
+				}
 			}
 
 			// If we didn't know it yet, query the stream duration
 			if e.duration.Load() == 0 {
-				var duration int64
-				if ok, duration = e.playbin.Load().QueryDuration(gst.FormatTime); !ok {
+				duration, ok := pl.Duration()
+				if !ok {
 					slog.Warn("Could not query current duration")
 				}
 				e.duration.Store(duration)
@@ -423,23 +559,15 @@ func (e *engine) performQueries(ctx context.Context) {
 			}
 
 			if !e.seekableDone.Load() {
-				q := gst.NewSeekingQuery(gst.FormatTime)
-				if e.playbin.Load().Query(q) {
-					var start, end int64
-					var format gst.Format
-					var seekable bool
-					format, seekable, start, end = q.ParseSeeking()
+				if seekable, ok := pl.Seekable(); ok {
 					e.seekable.Store(seekable)
 					if e.seekable.Load() {
-						slog.With(
-							"format", format,
-							"start", start,
-							"end", end,
-						).
-							Debug("Seeking is ENABLED")
+						slog.Debug("Seeking is ENABLED")
 						go func() {
 							if e.pb.Load().Skip > 0 {
 								GetEventsInstance().SeekInStream(e.pb.Load().Skip)
+							} else if e.getRate() != 1 {
+								e.applyRate()
 							}
 						}()
 					} else {
@@ -454,6 +582,180 @@ func (e *engine) performQueries(ctx context.Context) {
 	}
 }
 
+// queueNextStream is the handler for the pipeline's about-to-finish message.
+// It fetches the next stream and hands it to the running pipeline, so
+// there is no gap between tracks.
+func (e *engine) queueNextStream() {
+	if e.terminate.Load() ||
+		e.lastEvent.Load() == stopAllEvent ||
+		e.getPlaybackHint(true) != hintNone ||
+		e.sleepsAtEndOfStream() {
+		return
+	}
+
+	pl := e.pipeline.Load()
+	if pl == nil || e.next.Load() != nil {
+		return
+	}
+
+	ns := e.fetchNextStream()
+	if ns == nil {
+		return
+	}
+
+	e.next.Store(ns)
+
+	onerror.Log(pl.SetLocation(ns.pb.Location))
+	slog.Debug("Next stream queued", "location", ns.pb.Location)
+}
+
+// mustCrossfade returns true if the transition between the given
+// streams should be crossfaded.
+func (e *engine) mustCrossfade(curr, next *models.Playback) bool {
+	switch models.GetActivePerspectiveIndex() {
+	case models.AudiobooksPerspective, models.PodcastsPerspective:
+		return false
+	default:
+	}
+
+	if curr.TrackID == 0 || next.TrackID == 0 {
+		return true
+	}
+
+	t1, t2 := &models.Track{}, &models.Track{}
+	if err := t1.Read(curr.TrackID); err != nil {
+		return true
+	}
+	if err := t2.Read(next.TrackID); err != nil {
+		return true
+	}
+	return !t1.PrecedesInAlbum(t2)
+}
+
+// startCrossfade fades out the current stream, while the engine starts
+// the next one. If the transition must not be crossfaded, the next stream
+// is handed to the running pipeline instead.
+func (e *engine) startCrossfade() {
+	e.crossfadeDone.Store(true)
+
+	if e.terminate.Load() ||
+		e.lastEvent.Load() == stopAllEvent ||
+		e.getPlaybackHint(true) != hintNone ||
+		e.next.Load() != nil ||
+		e.sleepsAtEndOfStream() {
+		return
+	}
+
+	pl := e.pipeline.Load()
+	if pl == nil {
+		return
+	}
+
+	curr := e.pb.Load()
+	ns := e.fetchNextStream()
+	if ns == nil {
+		return
+	}
+
+	if !e.mustCrossfade(curr, ns.pb) {
+		slog.Info("Skipping crossfade", "pb", *ns.pb)
+		e.next.Store(ns)
+		onerror.Log(pl.SetLocation(ns.pb.Location))
+		return
+	}
+
+	slog.Info("Starting crossfade", "prev", *curr, "pb", *ns.pb)
+
+	d := crossfadeDuration()
+
+	e.fading.Store(pl)
+	e.fadeInPb.Store(ns.pb)
+	e.fadeIn.Store(int64(d))
+	e.setPlaybackHint(hintCrossfade)
+
+	e.wrapUp()
+	pl.Quit()
+
+	go e.fadeVolume(pl, 1, 0, d, func() {
+		if e.fading.CompareAndSwap(pl, nil) {
+			onerror.Log(pl.SetState(StateNull))
+		}
+	})
+}
+
+// cancelCrossfade stops the stream being faded out, if any, and discards
+// the stream that was about to fade in.
+func (e *engine) cancelCrossfade() {
+	if pl := e.fading.Swap(nil); pl != nil {
+		onerror.Log(pl.SetState(StateNull))
+	}
+
+	if pb := e.fadeInPb.Swap(nil); pb != nil {
+		pb.Discard()
+		if e.getPlaybackHint(true) == hintCrossfade {
+			e.setPlaybackHint(hintNone)
+		}
+	}
+}
+
+// discardNextStream undoes the effects of a queued stream that never
+// started, so that the engine can pick the right one afterwards.
+func (e *engine) discardNextStream() {
+	ns := e.next.Swap(nil)
+	if ns == nil {
+		return
+	}
+
+	slog.Info("Discarding next stream", "pb", *ns.pb)
+
+	if ns.prevPt != nil {
+		e.pt.Store(ns.prevPt)
+		ns.pb.Discard()
+	}
+}
+
+// startNextStream wraps up the current stream and makes the queued stream,
+// if any, the current one.
+func (e *engine) startNextStream() {
+	ns := e.next.Swap(nil)
+	if ns == nil {
+		return
+	}
+
+	prev := e.pb.Load()
+	slog.Info("Starting next stream", "prev", *prev, "pb", *ns.pb)
+
+	position := e.duration.Load()
+	if position == 0 {
+		position = e.lastPosition.Load()
+	}
+	go models.AddPlaybackToHistory(
+		prev.ID,
+		position,
+		e.duration.Load(),
+		e.freezePlayback.Load(),
+	)
+	go scrobble.Played(prev.TrackID, position)
+
+	e.applyResumePosition(ns.pb)
+	e.pb.Store(ns.pb)
+	e.t.Store(nil)
+	e.streamInfo.Store(nil)
+	e.applyReplayGain(ns.pb)
+	e.applyEqualizer(ns.pb)
+	e.discoverSeekable(ns.pb)
+	e.seekable.Store(false)
+	e.seekableDone.Store(false)
+	e.crossfadeDone.Store(false)
+	e.lastPosition.Store(0)
+	e.duration.Store(0)
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	e.updateMPRIS(false)
+	go e.updateBroadcastTitle()
+	go e.updateNowPlaying()
+}
+
 func (e *engine) resumeActivePlaylist() {
 	e.pt.Store(models.GetActivePlaylistTrack())
 	if e.pt.Load() != nil {
@@ -462,11 +764,16 @@ func (e *engine) resumeActivePlaylist() {
 }
 
 func (e *engine) reset() {
+	e.discardNextStream()
+
 	e.pb.Store(nil)
 	e.t.Store(nil)
+	e.streamInfo.Store(nil)
 	e.seekable.Store(false)
 	e.seekableDone.Store(false)
-	e.playbin.Store(nil)
+	e.setCanSeek(false)
+	e.crossfadeDone.Store(false)
+	e.pipeline.Store(nil)
 
 	e.lastPosition.Store(0)
 	e.duration.Store(0)
@@ -474,7 +781,7 @@ func (e *engine) reset() {
 }
 
 func (e *engine) setPlaybackHint(h playbackHint) {
-	e.hint = h
+	e.hint.Store(int32(h))
 }
 
 func (e *engine) updateMPRIS(destroy bool) {
@@ -482,7 +789,13 @@ func (e *engine) updateMPRIS(destroy bool) {
 		if e.mpris != nil {
 			e.mpris.Delete()
 		}
+		if e.mprisQuit != nil {
+			close(e.mprisQuit)
+		}
 		e.mpris = nil
+		e.mprisTracks = nil
+		e.mprisPlaylists = nil
+		e.mprisQuit = nil
 	}
 
 	if destroy {
@@ -490,22 +803,42 @@ func (e *engine) updateMPRIS(destroy bool) {
 		return
 	}
 
+	if e.mprisOff {
+		return
+	}
+
 	if e.mpris == nil {
 		mprisInstance := mpris.New()
-		e.mpris = &Player{mprisInstance, PlaybackStatusStopped}
-		if err := mprisInstance.Setup(e.mpris); err != nil {
+		e.mpris = &Player{
+			Instance:           mprisInstance,
+			lastPlaybackStatus: PlaybackStatusStopped,
+		}
+		e.mprisTracks = newTrackList(mprisInstance)
+		e.mprisPlaylists = newPlaylists(mprisInstance)
+		err := mprisInstance.Setup(e.mpris, e.mprisTracks, e.mprisPlaylists)
+		if err != nil {
 			slog.Error("Failed to setup mpris instance", "error", err)
 			deleteMPRIS()
+			return
 		}
+		e.mprisQuit = make(chan struct{})
+		go watchStores(e.mprisQuit, e.mprisTracks, e.mprisPlaylists)
 		return
 	}
 
+	var currPbID int64
+	if pb := e.pb.Load(); pb != nil {
+		currPbID = pb.ID
+	}
+
 	currPbStatus := e.mpris.PlaybackStatus()
-	if currPbStatus == e.mpris.lastPlaybackStatus {
+	if currPbStatus == e.mpris.lastPlaybackStatus &&
+		currPbID == e.mpris.lastPlaybackID {
 		return
 	}
 
 	e.mpris.lastPlaybackStatus = currPbStatus
+	e.mpris.lastPlaybackID = currPbID
 	err := e.mpris.Conn.Load().Emit(
 		mpris.RootPath,
 		mpris.PropertiesInterface+".PropertiesChanged",
@@ -520,6 +853,40 @@ func (e *engine) updateMPRIS(destroy bool) {
 	onerror.Warn(err)
 }
 
+// watchStores refreshes the MPRIS track list and playlists whenever the
+// queue or playbar stores change, until quit is closed or the
+// subscriptions are unloaded.
+func watchStores(quit chan struct{}, tl *TrackList, pls *Playlists) {
+	qsub, _ := subscription.Subscribe(subscription.ToQueueStoreEvent)
+	bsub, _ := subscription.Subscribe(subscription.ToPlaybarStoreEvent)
+	defer func() {
+		qsub.Unsubscribe()
+		bsub.Unsubscribe()
+	}()
+
+	// both subscriptions must be drained when unloading
+	qevents, bevents := qsub.Event, bsub.Event
+	for qevents != nil || bevents != nil {
+		select {
+		case <-quit:
+			return
+		case e := <-qevents:
+			if qsub.MustUnsubscribe(e) {
+				qevents = nil
+				continue
+			}
+			tl.refresh()
+		case e := <-bevents:
+			if bsub.MustUnsubscribe(e) {
+				bevents = nil
+				continue
+			}
+			tl.refresh()
+			pls.refresh(e)
+		}
+	}
+}
+
 func (e *engine) wrapUp() {
 	defer e.terminate.Store(true)
 
@@ -527,10 +894,176 @@ func (e *engine) wrapUp() {
 		return
 	}
 
-	go models.AddPlaybackToHistory(
-		e.pb.Load().ID,
+	// the playback must be marked as played before the engine loop looks
+	// for the next one, or it would be picked again
+	pb := e.pb.Load()
+	models.AddPlaybackToHistory(
+		pb.ID,
 		e.lastPosition.Load(),
 		e.duration.Load(),
 		e.freezePlayback.Load(),
 	)
+	go scrobble.Played(pb.TrackID, e.lastPosition.Load())
+}
+
+func (e *engine) getRate() float64 {
+	return math.Float64frombits(e.rate.Load())
+}
+
+// applyRate applies the current rate to the running stream.
+func (e *engine) applyRate() {
+	pl := e.pipeline.Load()
+	if pl == nil || !e.seekable.Load() {
+		return
+	}
+
+	position, ok := pl.Position()
+	if !ok {
+		position = e.lastPosition.Load()
+	}
+	e.seekTo(position, true)
+}
+
+// applyResumePosition makes the given stream start at the position it was
+// last stopped at, unless it was requested to start from the beginning.
+func (e *engine) applyResumePosition(pb *models.Playback) {
+	if e.fromBeginning.Swap(false) || pb.Skip > 0 {
+		return
+	}
+
+	if pos := models.GetResumePosition(pb.TrackID); pos > 0 {
+		slog.Info("Resuming stream", "pb", *pb, "position", pos)
+		pb.Skip = pos
+	}
+}
+
+// getPosition returns the current position of the running stream.
+func (e *engine) getPosition() int64 {
+	pl := e.pipeline.Load()
+	if pl != nil {
+		switch e.state.Load() {
+		case StatePlaying, StatePaused:
+			if position, ok := pl.Position(); ok {
+				return position
+			}
+		default:
+		}
+	}
+	return e.lastPosition.Load()
+}
+
+// discoverSeekable finds out, in the background, whether the given stream
+// is seekable or live, and its duration.
+func (e *engine) discoverSeekable(pb *models.Playback) {
+	e.live.Store(false)
+	e.setCanSeek(false)
+
+	go func() {
+		info, err := e.backend.Discover(pb.Location)
+		if err != nil {
+			slog.Warn("Failed to discover stream", "location", pb.Location, "error", err)
+			return
+		}
+
+		if e.pb.Load() != pb {
+			return
+		}
+		e.live.Store(info.Live)
+		e.duration.CompareAndSwap(0, info.Duration)
+		e.setCanSeek(info.Seekable && !info.Live)
+	}()
+}
+
+func (e *engine) setCanSeek(canSeek bool) {
+	if e.canSeek.Swap(canSeek) == canSeek {
+		return
+	}
+	if m := e.mpris; m != nil {
+		go m.SetPlayerProperty("CanSeek", canSeek)
+	}
+}
+
+// seekTo seeks the given position in the running stream, keeping the
+// current rate.
+func (e *engine) seekTo(pos int64, accurate bool) {
+	pl := e.pipeline.Load()
+	if pl == nil {
+		return
+	}
+
+	if !pl.SeekTo(pos, e.getRate(), accurate) {
+		slog.Error("Failed to seek in stream", "position", pos)
+	}
+}
+
+// setPerspectiveRate sets the default rate for the active perspective, if
+// the perspective changed since the rate was last chosen.
+func (e *engine) setPerspectiveRate() {
+	idx := models.GetActivePerspectiveIndex()
+	if e.rateIdx.Swap(int32(idx)) == int32(idx) {
+		return
+	}
+
+	rate := base.Conf.Server.GetDefaultRate(idx.String())
+	rate = max(MinimumRate, min(MaximumRate, rate))
+	e.rate.Store(math.Float64bits(rate))
+	slog.Info("Using default rate for perspective", "perspective", idx, "rate", rate)
+
+	if e.mpris != nil {
+		go e.mpris.SetPlayerProperty("Rate", rate)
+	}
+}
+
+func (e *engine) getVolume() float64 {
+	return math.Float64frombits(e.volume.Load())
+}
+
+// saveVolume persists the current volume and mute settings.
+func (e *engine) saveVolume() {
+	ps := models.GetPlaybackSettings()
+	ps.Volume = e.getVolume()
+	ps.Mute = e.mute.Load()
+	onerror.Log(ps.Save())
+}
+
+// repeatStream adds the given playback again.
+func repeatStream(pb *models.Playback) *models.Playback {
+	slog.Info("Repeating stream", "pb", *pb)
+
+	if pb.TrackID > 0 {
+		t := &models.Track{}
+		if err := t.Read(pb.TrackID); err == nil {
+			// a repeated track always starts over
+			models.ClearResumePosition(t.ID)
+			return models.AddPlaybackTrack(t)
+		}
+	}
+	return models.AddPlaybackLocation(pb.Location)
+}
+
+func crossfadeDuration() time.Duration {
+	return time.Duration(base.Conf.Server.Playback.Crossfade.Duration) * time.Second
+}
+
+// fadeVolume ramps the volume of the given pipeline during the given
+// duration, and calls done, if given, at the end. The from and to values
+// are fractions of the current volume.
+func (e *engine) fadeVolume(pl Pipeline, from, to float64,
+	d time.Duration, done func()) {
+
+	const steps = 50
+
+	interval := d / steps
+	for i := 1; i <= steps; i++ {
+		time.Sleep(interval)
+		vol := e.getVolume() * (from + (to-from)*float64(i)/steps)
+		if err := pl.SetVolume(vol); err != nil {
+			slog.Warn("Failed to set volume", "error", err)
+			break
+		}
+	}
+
+	if done != nil {
+		done()
+	}
 }
@@ -0,0 +1,289 @@
+package playback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const (
+	waitTimeout = 5 * time.Second
+	waitTick    = 10 * time.Millisecond
+)
+
+type fixturesDir string
+
+func (fd fixturesDir) FixturesDir() string {
+	return string(fd)
+}
+
+// startFakeEngine starts the engine with the fake backend, over the
+// given fixtures, and stops it when the test is done.
+func startFakeEngine(t *testing.T, fd fixturesDir) (*fakeBackend, *gorm.DB) {
+	db := tests.SetupTest(t, fd)
+
+	fb := newFakeBackend()
+	instance = nil
+	GetEventsInstance()
+	instance.eng.mprisOff = true
+	startEngine(fb)
+
+	t.Cleanup(func() {
+		stopEngine()
+		tests.TeardownTest(t)
+	})
+	return fb, db
+}
+
+// waitForTrack waits until the given track is playing.
+func waitForTrack(t *testing.T, trackID int64) {
+	require.Eventually(t, func() bool {
+		pb := instance.eng.pb.Load()
+		return pb != nil && pb.TrackID == trackID && instance.IsPlaying()
+	}, waitTimeout, waitTick, "track %d is not playing", trackID)
+}
+
+// waitForHistory waits until the history holds the given tracks, in order.
+func waitForHistory(t *testing.T, db *gorm.DB, trackIDs ...int64) {
+	assert.Eventually(t, func() bool {
+		hs := []models.PlaybackHistory{}
+		if err := db.Order("id ASC").Find(&hs).Error; err != nil {
+			return false
+		}
+		if len(hs) != len(trackIDs) {
+			return false
+		}
+		for i := range hs {
+			if hs[i].TrackID != trackIDs[i] {
+				return false
+			}
+		}
+		return true
+	}, waitTimeout, waitTick, "history does not hold tracks %v", trackIDs)
+}
+
+// playFromBar plays the given playlist from the given position.
+func playFromBar(t *testing.T, plID int64, position int) {
+	pl := &models.Playlist{}
+	require.NoError(t, pl.Read(plID))
+
+	// let the engine loop settle, so the playlist's start is not taken
+	// for the startup signal
+	require.Eventually(t, func() bool {
+		return len(models.PlaybackChanged) == 0
+	}, waitTimeout, waitTick, "engine loop did not settle")
+
+	instance.TryPlayingFromBar(pl, position)
+}
+
+// queuedLocation returns the location queued to follow in the running
+// pipeline.
+func queuedLocation(t *testing.T) string {
+	p, ok := instance.eng.pipeline.Load().(*fakePipeline)
+	require.True(t, ok)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.next
+}
+
+func TestQueueFlow(t *testing.T) {
+	fb, db := startFakeEngine(t, "playback/queue-flow")
+
+	waitForTrack(t, 1)
+
+	fb.advance(fakeDefaultDuration)
+	waitForTrack(t, 2)
+	waitForHistory(t, db, 1)
+
+	fb.advance(fakeDefaultDuration)
+	require.Eventually(t, func() bool {
+		return instance.eng.pipeline.Load() == nil
+	}, waitTimeout, waitTick, "playback did not stop")
+	waitForHistory(t, db, 1, 2)
+
+	q, err := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
+	require.NoError(t, err)
+	assert.True(t, q.IsEmpty())
+}
+
+func TestPlaylistFlow(t *testing.T) {
+	fb, db := startFakeEngine(t, "playback/playlist-flow")
+
+	playFromBar(t, 1, 1)
+	waitForTrack(t, 1)
+
+	fb.advance(fakeDefaultDuration)
+	waitForTrack(t, 2)
+
+	fb.advance(fakeDefaultDuration)
+	waitForTrack(t, 3)
+
+	fb.advance(fakeDefaultDuration)
+	require.Eventually(t, func() bool {
+		return instance.eng.pipeline.Load() == nil
+	}, waitTimeout, waitTick, "playback did not stop")
+	waitForHistory(t, db, 1, 2, 3)
+	assert.Nil(t, instance.eng.pt.Load())
+}
+
+func TestHistoryFlow(t *testing.T) {
+	fb, db := startFakeEngine(t, "playback/history-flow")
+
+	waitForTrack(t, 1)
+
+	fb.advance(fakeDefaultDuration)
+	waitForTrack(t, 2)
+
+	fb.advance(fakeDefaultDuration)
+	require.Eventually(t, func() bool {
+		return instance.eng.pipeline.Load() == nil
+	}, waitTimeout, waitTick, "playback did not stop")
+	waitForHistory(t, db, 1, 2)
+
+	instance.PreviousStream()
+	waitForTrack(t, 2)
+
+	fb.advance(fakeDefaultDuration)
+	require.Eventually(t, func() bool {
+		return instance.eng.pipeline.Load() == nil
+	}, waitTimeout, waitTick, "playback did not stop")
+	waitForHistory(t, db, 1, 2, 2)
+}
+
+func TestCueSheetFlow(t *testing.T) {
+	fb, db := startFakeEngine(t, "playback/cue-flow")
+
+	waitForTrack(t, 1)
+	d, ok := instance.eng.pipeline.Load().Duration()
+	assert.True(t, ok)
+	assert.Equal(t, int64(time.Minute), d, "only the track's range is played")
+
+	fb.advance(time.Minute)
+	waitForTrack(t, 2)
+	d, ok = instance.eng.pipeline.Load().Duration()
+	assert.True(t, ok)
+	assert.Equal(t, int64(fakeDefaultDuration-time.Minute), d, "the last range ends with the file")
+
+	fb.advance(fakeDefaultDuration - time.Minute)
+	require.Eventually(t, func() bool {
+		return instance.eng.pipeline.Load() == nil
+	}, waitTimeout, waitTick, "playback did not stop")
+	waitForHistory(t, db, 1, 2)
+}
+
+func TestGaplessHandoff(t *testing.T) {
+	fb, db := startFakeEngine(t, "playback/playlist-flow")
+
+	playFromBar(t, 1, 1)
+	waitForTrack(t, 1)
+
+	e := instance.eng
+	pl := e.pipeline.Load()
+
+	// like the fake pipeline, the about-to-finish message is handled
+	// right away, while the current stream is still playing
+
+	t.Run("playlist item is discarded", func(t *testing.T) {
+		e.queueNextStream()
+		ns := e.next.Load()
+		require.NotNil(t, ns)
+		assert.Equal(t, int64(2), ns.pb.TrackID)
+		require.NotNil(t, ns.prevPt)
+		assert.Equal(t, int64(1), ns.prevPt.ID)
+		assert.Equal(t, int64(2), e.pt.Load().ID)
+		assert.Equal(t, ns.pb.Location, queuedLocation(t))
+
+		e.discardNextStream()
+		assert.Nil(t, e.next.Load())
+		assert.Equal(t, int64(1), e.pt.Load().ID, "playlist track is restored")
+
+		pb := &models.Playback{}
+		assert.Error(t, pb.GetNextToPlay(e.pb.Load().ID), "nothing is pending")
+	})
+
+	t.Run("queue item stays pending", func(t *testing.T) {
+		q, err := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
+		require.NoError(t, err)
+		q.Add(nil, []int64{3})
+
+		e.queueNextStream()
+		ns := e.next.Load()
+		require.NotNil(t, ns)
+		assert.Equal(t, int64(3), ns.pb.TrackID)
+		assert.Nil(t, ns.prevPt)
+		assert.Equal(t, int64(1), e.pt.Load().ID)
+		assert.True(t, q.IsEmpty())
+
+		e.discardNextStream()
+		assert.Nil(t, e.next.Load())
+		assert.Equal(t, int64(1), e.pt.Load().ID)
+
+		pb := &models.Playback{}
+		require.NoError(t, pb.GetNextToPlay(e.pb.Load().ID))
+		assert.Equal(t, ns.pb.ID, pb.ID)
+	})
+
+	t.Run("next stream starts in the same pipeline", func(t *testing.T) {
+		e.queueNextStream()
+		ns := e.next.Load()
+		require.NotNil(t, ns)
+		assert.Equal(t, int64(3), ns.pb.TrackID, "pending item goes first")
+
+		e.queueNextStream()
+		assert.Same(t, ns, e.next.Load(), "next stream is queued once")
+
+		fb.advance(fakeDefaultDuration)
+		waitForTrack(t, 3)
+		assert.Same(t, pl, e.pipeline.Load())
+		assert.Nil(t, e.next.Load())
+		waitForHistory(t, db, 1)
+
+		fb.advance(fakeDefaultDuration)
+		waitForTrack(t, 2)
+		assert.Same(t, pl, e.pipeline.Load())
+		waitForHistory(t, db, 1, 3)
+	})
+}
+
+func TestMustCrossfade(t *testing.T) {
+	tests.SetupTest(t, fixturesDir("playback/crossfade"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	e := &engine{}
+
+	testCases := []struct {
+		name        string
+		perspective models.PerspectiveIndex
+		curr, next  int64 // track IDs
+		want        bool
+	}{
+		{"same album back to back", models.MusicPerspective, 1, 2, false},
+		{"same album with a gap", models.MusicPerspective, 2, 3, true},
+		{"same album going back", models.MusicPerspective, 2, 1, true},
+		{"another album", models.MusicPerspective, 2, 4, true},
+		{"no current track", models.MusicPerspective, 0, 2, true},
+		{"no next track", models.MusicPerspective, 1, 0, true},
+		{"unknown track", models.MusicPerspective, 1, 99, true},
+		{"radio", models.RadioPerspective, 2, 4, true},
+		{"audiobooks", models.AudiobooksPerspective, 2, 4, false},
+		{"podcasts", models.PodcastsPerspective, 2, 4, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.perspective.Activate())
+
+			got := e.mustCrossfade(
+				&models.Playback{TrackID: tc.curr},
+				&models.Playback{TrackID: tc.next},
+			)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
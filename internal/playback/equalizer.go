@@ -0,0 +1,43 @@
+package playback
+
+import (
+	"log/slog"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+)
+
+// applyEqualizer sets the pipeline's equalizer bands for the given
+// playback, according to the preset that applies to it.
+func (e *engine) applyEqualizer(pb *models.Playback) {
+	pl := e.pipeline.Load()
+	if pl == nil {
+		return
+	}
+
+	var t *models.Track
+	if pb != nil && pb.TrackID > 0 {
+		t = &models.Track{}
+		if err := t.Read(pb.TrackID); err != nil {
+			slog.With(
+				"track_id", pb.TrackID,
+				"error", err,
+			).Error("Failed to read track")
+			t = nil
+		}
+	}
+
+	bands := models.EqualizerBands{}
+	ep := models.GetEqualizerPresetFor(t, models.GetActivePerspectiveIndex())
+	if ep != nil {
+		bands = ep.Bands
+	}
+
+	if err := pl.SetEqualizer(bands); err != nil {
+		slog.Warn("Failed to apply equalizer", "error", err)
+		return
+	}
+
+	if ep != nil {
+		slog.Debug("Equalizer preset applied", "preset", ep.Name)
+	}
+}
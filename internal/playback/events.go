@@ -2,10 +2,10 @@ package playback
 
 import (
 	"log/slog"
+	"math"
 	"sync/atomic"
 	"time"
 
-	"github.com/go-gst/go-gst/gst"
 	"github.com/jwmwalrus/bnp/onerror"
 	"github.com/jwmwalrus/m3u-etcetera/internal/base"
 	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
@@ -69,6 +69,12 @@ var (
 	}
 )
 
+// Playback rate limits.
+const (
+	MinimumRate = 0.5
+	MaximumRate = 3.0
+)
+
 // IEvents defines the events interface.
 type IEvents interface {
 	// getters:
@@ -76,8 +82,42 @@ type IEvents interface {
 	// GetPlayback returns a copy of the current playback.
 	GetPlayback() (pb *models.Playback, t *models.Track)
 
+	// GetBroadcast returns the state of the broadcast output.
+	GetBroadcast() BroadcastStatus
+
+	// GetOutputDevice returns the output the playback is routed to.
+	GetOutputDevice() OutputDevice
+
+	// GetOutputDevices returns the available outputs.
+	GetOutputDevices() []OutputDevice
+
+	// GetProgress returns the position, duration and buffering state of
+	// the current stream.
+	GetProgress() Progress
+
+	// GetRate returns the current playback rate.
+	GetRate() float64
+
+	// GetStreamInfo returns the metadata reported by the current stream,
+	// if it is a remote one.
+	GetStreamInfo() StreamInfo
+
+	// GetRepeatMode returns the repeat mode of the active perspective.
+	GetRepeatMode() models.RepeatMode
+
+	// GetShuffle returns true if shuffle is enabled for the active
+	// perspective.
+	GetShuffle() bool
+
+	// GetSleepTimer returns the current sleep timer and, when known, the
+	// time left for it to go off.
+	GetSleepTimer() (st SleepTimer, remaining time.Duration)
+
 	// GetState returns the current state of the playback.
-	GetState() gst.State
+	GetState() State
+
+	// GetVolume returns the current volume and mute settings.
+	GetVolume() (volume float64, mute bool)
 
 	// status:
 
@@ -91,6 +131,10 @@ type IEvents interface {
 	// IsPlaying checks if a stream is playing right now.
 	IsPlaying() bool
 
+	// IsCrossfadeEnabled returns true if crossfading between streams is
+	// enabled.
+	IsCrossfadeEnabled() bool
+
 	// IsReady checks if a stream is ready.
 	IsReady() bool
 
@@ -117,12 +161,54 @@ type IEvents interface {
 	// QuitPlayingFromBar stops reproducing a playlist.
 	QuitPlayingFromBar(pl *models.Playlist)
 
+	// ReloadEqualizer re-applies the equalizer preset to the current
+	// stream, after the presets or their selection changed.
+	ReloadEqualizer()
+
 	// SeekInStream seek a position in the current stream.
 	SeekInStream(pos int64)
 
+	// SetCrossfade enables or disables crossfading between streams.
+	SetCrossfade(enabled bool)
+
+	// SetFromBeginning makes the next stream to start ignore its resume
+	// position.
+	SetFromBeginning()
+
+	// SetMute mutes or unmutes playback.
+	SetMute(mute bool)
+
+	// SetOutputDevice routes the playback to the given output, switching
+	// the running stream, if any.
+	SetOutputDevice(od OutputDevice) error
+
+	// SetRate sets the playback rate, in the [MinimumRate, MaximumRate]
+	// range, keeping the pitch.
+	SetRate(rate float64)
+
+	// SetRepeatMode sets the repeat mode for the given perspective.
+	SetRepeatMode(idx models.PerspectiveIndex, rm models.RepeatMode) error
+
+	// SetShuffle enables or disables shuffle for the given perspective.
+	SetShuffle(idx models.PerspectiveIndex, shuffle bool) error
+
+	// SetSleepTimer sets or, if the mode is SleepOff, cancels the sleep
+	// timer.
+	SetSleepTimer(st SleepTimer) error
+
+	// SetVolume sets the playback volume, in the [0, 1] range.
+	SetVolume(volume float64)
+
+	// StartBroadcast starts serving the playback over HTTP, in the given
+	// format and bitrate, or the configured ones if not given.
+	StartBroadcast(format string, bitrate int) error
+
 	// StopAll stops all playback.
 	StopAll()
 
+	// StopBroadcast stops serving the playback over HTTP.
+	StopBroadcast()
+
 	// StopStream stops the current stream.
 	StopStream()
 
@@ -168,9 +254,13 @@ func (et *events) GetPlayback() (pb *models.Playback, t *models.Track) {
 				"location", pb.Location,
 				"error", err,
 			).Error("Failed to read tags for location")
-			return
+		} else {
+			et.eng.t.Store(t)
 		}
-		et.eng.t.Store(t)
+	}
+
+	if si := et.eng.getStreamInfo(); !si.IsEmpty() {
+		t = si.applyTo(t, pb.Location)
 	}
 
 	if t != nil && t.Duration == 0 {
@@ -181,13 +271,58 @@ func (et *events) GetPlayback() (pb *models.Playback, t *models.Track) {
 	return
 }
 
-func (et *events) GetState() gst.State {
+func (et *events) GetBroadcast() BroadcastStatus {
+	return et.eng.backend.BroadcastStatus()
+}
+
+func (et *events) GetOutputDevice() OutputDevice {
+	return getOutputDevice()
+}
+
+func (et *events) GetOutputDevices() []OutputDevice {
+	return et.eng.listOutputDevices()
+}
+
+func (et *events) GetProgress() Progress {
+	return et.eng.getProgress()
+}
+
+func (et *events) GetRate() float64 {
+	return et.eng.getRate()
+}
+
+func (et *events) GetStreamInfo() StreamInfo {
+	return et.eng.getStreamInfo()
+}
+
+func (et *events) GetRepeatMode() models.RepeatMode {
+	return et.eng.getRepeatMode()
+}
+
+func (et *events) GetShuffle() bool {
+	return et.eng.getShuffle()
+}
+
+func (et *events) GetSleepTimer() (st SleepTimer, remaining time.Duration) {
+	return et.eng.getSleepTimer()
+}
+
+func (et *events) GetState() State {
 	return et.eng.state.Load()
 }
 
+func (et *events) GetVolume() (volume float64, mute bool) {
+	return et.eng.getVolume(), et.eng.mute.Load()
+}
+
 func (et *events) HasNextStream() bool {
+	var excluded []int64
+	if curr := et.eng.pb.Load(); curr != nil {
+		excluded = append(excluded, curr.ID)
+	}
+
 	pb := &models.Playback{}
-	if pb.GetNextToPlay() != nil {
+	if pb.GetNextToPlay(excluded...) == nil {
 		return true
 	}
 
@@ -197,7 +332,7 @@ func (et *events) HasNextStream() bool {
 	}
 
 	if et.eng.pt.Load() != nil {
-		if _, err := et.eng.pt.Load().GetTrackAfter(false); err == nil {
+		if _, err := et.eng.getTrackAfter(et.eng.pt.Load(), false); err == nil {
 			return true
 		}
 	}
@@ -205,16 +340,20 @@ func (et *events) HasNextStream() bool {
 	return false
 }
 
+func (et *events) IsCrossfadeEnabled() bool {
+	return et.eng.crossfade.Load()
+}
+
 func (et *events) IsPaused() bool {
-	return et.eng.playbin.Load() != nil && et.eng.state.Load() == gst.StatePaused
+	return et.eng.pipeline.Load() != nil && et.eng.state.Load() == StatePaused
 }
 
 func (et *events) IsPlaying() bool {
-	return et.eng.playbin.Load() != nil && et.eng.state.Load() == gst.StatePlaying
+	return et.eng.pipeline.Load() != nil && et.eng.state.Load() == StatePlaying
 }
 
 func (et *events) IsReady() bool {
-	return et.eng.playbin.Load() != nil && et.eng.state.Load() == gst.StateReady
+	return et.eng.pipeline.Load() != nil && et.eng.state.Load() == StateReady
 }
 
 func (et *events) IsStreaming() bool {
@@ -239,7 +378,8 @@ func (et *events) NextStream() (err error) {
 }
 
 func (et *events) PauseStream(off bool) (err error) {
-	if et.eng.playbin.Load() == nil {
+	pl := et.eng.pipeline.Load()
+	if pl == nil {
 		if !et.IsStopped() {
 			return
 		}
@@ -254,14 +394,14 @@ func (et *events) PauseStream(off bool) (err error) {
 		if !et.IsPaused() {
 			return
 		}
-		et.eng.state.Store(gst.StatePlaying)
-		err = et.eng.playbin.Load().SetState(et.eng.state.Load())
+		et.eng.state.Store(StatePlaying)
+		err = pl.SetState(et.eng.state.Load())
 	} else {
 		if !et.IsPlaying() {
 			return
 		}
-		et.eng.state.Store(gst.StatePaused)
-		err = et.eng.playbin.Load().SetState(et.eng.state.Load())
+		et.eng.state.Store(StatePaused)
+		err = pl.SetState(et.eng.state.Load())
 	}
 
 	broadcastToSubscribers(subscription.ToPlaybackEvent)
@@ -337,6 +477,10 @@ func (et *events) QuitPlayingFromBar(pl *models.Playlist) {
 	et.quitPlayingFromList()
 }
 
+func (et *events) ReloadEqualizer() {
+	et.eng.applyEqualizer(et.eng.pb.Load())
+}
+
 func (et *events) SeekInStream(pos int64) {
 	et.eng.lastEvent.Store(seekEvent)
 
@@ -345,25 +489,149 @@ func (et *events) SeekInStream(pos int64) {
 	}
 
 	if et.eng.seekable.Load() {
-		seek := gst.NewSeekEvent(
-			1.0,
-			gst.FormatTime,
-			gst.SeekFlagFlush|gst.SeekFlagKeyUnit,
-			gst.SeekTypeSet,
-			pos,
-			gst.SeekTypeNone,
-			-1,
-		)
-
-		if !et.eng.playbin.Load().SendEvent(seek) {
-			slog.Error("Failed to send playback event", "event", gst.EventTypeSeek)
+		et.eng.seekTo(pos, false)
+		et.eng.lastPosition.Store(pos)
+		if m := et.eng.mpris; m != nil {
+			go func() {
+				m.SetPlayerProperty("Position", pos/int64(time.Microsecond))
+				m.EmitSeeked(pos / int64(time.Microsecond))
+			}()
 		}
 	}
 }
 
+func (et *events) SetCrossfade(enabled bool) {
+	slog.Info("Setting crossfade", "enabled", enabled)
+
+	et.eng.crossfade.Store(enabled)
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+}
+
+func (et *events) SetFromBeginning() {
+	et.eng.fromBeginning.Store(true)
+}
+
+func (et *events) SetMute(mute bool) {
+	if et.eng.mute.Swap(mute) == mute {
+		return
+	}
+	slog.Info("Setting mute", "mute", mute)
+
+	if pl := et.eng.pipeline.Load(); pl != nil {
+		onerror.Log(pl.SetMute(mute))
+	}
+
+	et.eng.saveVolume()
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+}
+
+func (et *events) SetOutputDevice(od OutputDevice) error {
+	return et.eng.setOutputDevice(od)
+}
+
+func (et *events) SetRate(rate float64) {
+	rate = max(MinimumRate, min(MaximumRate, rate))
+	if math.Float64bits(rate) == et.eng.rate.Swap(math.Float64bits(rate)) {
+		return
+	}
+	slog.Info("Setting rate", "rate", rate)
+
+	if et.IsStreaming() {
+		et.eng.applyRate()
+	}
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	if et.eng.mpris != nil {
+		go et.eng.mpris.SetPlayerProperty("Rate", rate)
+	}
+}
+
+func (et *events) SetRepeatMode(idx models.PerspectiveIndex,
+	rm models.RepeatMode) (err error) {
+
+	bar, err := idx.GetPlaybar()
+	if err != nil {
+		return
+	}
+
+	if err = bar.SetRepeatMode(rm); err != nil {
+		return
+	}
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	if et.eng.mpris != nil && idx == models.GetActivePerspectiveIndex() {
+		go et.eng.mpris.SetPlayerProperty("LoopStatus", rm.String())
+	}
+	return
+}
+
+func (et *events) SetShuffle(idx models.PerspectiveIndex,
+	shuffle bool) (err error) {
+
+	bar, err := idx.GetPlaybar()
+	if err != nil {
+		return
+	}
+
+	if err = bar.SetShuffle(shuffle); err != nil {
+		return
+	}
+
+	if idx == models.GetActivePerspectiveIndex() {
+		et.eng.resetShuffleOrder()
+	}
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	if et.eng.mpris != nil && idx == models.GetActivePerspectiveIndex() {
+		go et.eng.mpris.SetPlayerProperty("Shuffle", shuffle)
+	}
+	return
+}
+
+func (et *events) SetSleepTimer(st SleepTimer) error {
+	return et.eng.setSleepTimer(st)
+}
+
+func (et *events) SetVolume(volume float64) {
+	volume = max(0, min(1, volume))
+	if math.Float64bits(volume) == et.eng.volume.Swap(math.Float64bits(volume)) {
+		return
+	}
+	slog.Info("Setting volume", "volume", volume)
+
+	if pl := et.eng.pipeline.Load(); pl != nil {
+		onerror.Log(pl.SetVolume(volume))
+	}
+
+	et.eng.saveVolume()
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	if et.eng.mpris != nil {
+		// NOTE: the change might come from MPRIS itself, which holds
+		// the properties lock while calling back
+		go et.eng.mpris.SetPlayerProperty("Volume", volume)
+	}
+}
+
+func (et *events) StartBroadcast(format string, bitrate int) error {
+	if err := et.eng.backend.StartBroadcast(format, bitrate); err != nil {
+		return err
+	}
+
+	go func() {
+		et.eng.updateBroadcastTitle()
+		et.eng.switchOutput(getOutputDevice())
+	}()
+	return nil
+}
+
+func (et *events) StopBroadcast() {
+	et.eng.backend.StopBroadcast()
+}
+
 func (et *events) StopAll() {
 	et.eng.lastEvent.Store(stopAllEvent)
 
+	et.eng.cancelCrossfade()
 	et.eng.updateMPRIS(true)
 	et.StopStream()
 }
@@ -382,12 +650,16 @@ func (et *events) StopStream() {
 	// NOTE: sending the EOS event has between 1.5 and 3 seconds of
 	// latency, and conflicts with the paused state, so we are
 	// just ending things here
+	pl := et.eng.pipeline.Load()
+	if pl == nil {
+		return
+	}
 	if !et.IsPaused() {
-		et.eng.state.Store(gst.StatePaused)
-		onerror.Log(et.eng.playbin.Load().SetState(et.eng.state.Load()))
+		et.eng.state.Store(StatePaused)
+		onerror.Log(pl.SetState(et.eng.state.Load()))
 	}
 	et.eng.wrapUp()
-	et.eng.mainLoop.Quit()
+	pl.Quit()
 }
 
 func (et *events) TryPlayingFromBar(pl *models.Playlist, position int) {
@@ -432,6 +704,7 @@ func (et *events) tryPlayingFromList(pl *models.Playlist, position int) {
 	}
 
 	et.eng.pt.Store(pt)
+	et.eng.resetShuffleOrder()
 
 	et.eng.setPlaybackHint(hintStartPlaylist)
 	et.StopStream()
@@ -442,24 +715,41 @@ func (et *events) tryPlayingFromList(pl *models.Playlist, position int) {
 func GetEventsInstance() IEvents {
 	if instance == nil {
 		instance = &events{
-			&engine{
-				hint: hintNone,
-			},
+			&engine{},
 		}
-		instance.eng.state.Store(gst.StateNull)
+		instance.eng.rate.Store(math.Float64bits(1))
+		instance.eng.rateIdx.Store(-1)
+		instance.eng.state.Store(StateNull)
 		instance.eng.lastEvent.Store(noLoopEvent)
 	}
 	return instance
 }
 
-// StartEngine starts the playback engine.
+// StartEngine starts the playback engine, with the configured backend.
 func StartEngine() *rtc.Unloader {
-	slog.Info("Starting playback engine")
+	b, err := newBackend(base.Conf.Server.Playback.Backend)
+	onerror.Fatal(err)
 
-	gst.Init(nil)
+	return startEngine(b)
+}
+
+func startEngine(b Backend) *rtc.Unloader {
+	slog.Info("Starting playback engine")
 
 	GetEventsInstance()
 
+	instance.eng.backend = b
+
+	instance.eng.crossfade.Store(base.Conf.Server.Playback.Crossfade.Enabled)
+
+	ps := models.GetPlaybackSettings()
+	instance.eng.volume.Store(math.Float64bits(ps.Volume))
+	instance.eng.mute.Store(ps.Mute)
+
+	if base.Conf.Server.Broadcast.AutoStart {
+		onerror.Log(instance.StartBroadcast("", 0))
+	}
+
 	instance.eng.resumeActivePlaylist()
 	go instance.eng.engineLoop()
 	models.TriggerPlaybackChange()
@@ -475,9 +765,10 @@ func stopEngine() {
 
 	instance.eng.freezePlayback.Store(true)
 	instance.StopAll()
+	instance.StopBroadcast()
 
 	for i := 0; i < base.ServerWaitTimeout; i++ {
-		if instance.eng.playbin.Load() != nil {
+		if instance.eng.pipeline.Load() != nil {
 			time.Sleep(1 * time.Second)
 			continue
 		}
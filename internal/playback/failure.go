@@ -0,0 +1,101 @@
+package playback
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+
+	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+)
+
+var (
+	// codecFailureHints hint at a stream that could not be decoded.
+	codecFailureHints = []string{
+		"missing a plug-in",
+		"no decoder",
+		"codec",
+		"could not determine type",
+		"not supported",
+		"typefind",
+	}
+
+	// networkFailureHints hint at a stream that could not be fetched.
+	networkFailureHints = []string{
+		"souphttpsrc",
+		"could not resolve",
+		"could not connect",
+		"connection",
+		"timed out",
+	}
+)
+
+// failStream records the failure of the given playback and lets
+// subscribers know about it.
+func (e *engine) failStream(pb *models.Playback, err error) {
+	if pb == nil {
+		return
+	}
+
+	msg := ""
+	if err != nil {
+		msg = err.Error()
+	}
+	pf := models.AddPlaybackFailure(pb, failureReason(pb.Location, err), msg)
+
+	broadcastToSubscribers(
+		subscription.ToPlaybackEvent,
+		subscription.Event{
+			Idx:  int(models.PlaybackEventError),
+			Data: pf,
+		},
+	)
+}
+
+// failureReason guesses why the given location failed to play, from the
+// location itself and the reported error. GStreamer does not expose the
+// error domain through the bindings, so its message and, if available,
+// debug string are inspected instead.
+func failureReason(location string, err error) models.FailureReason {
+	if !isRemoteLocation(location) && isMissingFile(location) {
+		return models.FailureReasonMissingFile
+	}
+	if err == nil {
+		return models.FailureReasonUnknown
+	}
+
+	text := err.Error()
+	if derr, ok := err.(interface{ DebugString() string }); ok {
+		text += " " + derr.DebugString()
+	}
+	text = strings.ToLower(text)
+
+	containsAny := func(hints []string) bool {
+		for _, h := range hints {
+			if strings.Contains(text, h) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch {
+	case containsAny(codecFailureHints):
+		return models.FailureReasonUnsupportedCodec
+	case isRemoteLocation(location) || containsAny(networkFailureHints):
+		return models.FailureReasonNetwork
+	}
+	return models.FailureReasonUnknown
+}
+
+func isMissingFile(location string) bool {
+	path, err := urlstr.URLToPath(cuesheet.Source(location))
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return errors.Is(err, fs.ErrNotExist)
+}
@@ -0,0 +1,376 @@
+package playback
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/discover"
+)
+
+const (
+	// fakeTickInterval defines how often the virtual clock advances when
+	// the fake backend runs on its own, i.e., when chosen in the config.
+	fakeTickInterval = 100 * time.Millisecond
+
+	// fakeDefaultDuration defines the duration of the streams played by
+	// the fake backend, unless set otherwise.
+	fakeDefaultDuration = 3 * time.Minute
+
+	// fakeMessageBacklog defines how many messages a fake pipeline holds
+	// for its handler.
+	fakeMessageBacklog = 64
+)
+
+func init() {
+	RegisterBackend(config.PlaybackBackendFake, func() (Backend, error) {
+		fb := newFakeBackend()
+		go fb.tick(fakeTickInterval)
+		return fb, nil
+	})
+}
+
+// fakeBackend implements the Backend interface. Its pipelines play
+// nothing, but move along a virtual clock and post the messages a real
+// pipeline would, so the engine can run without GStreamer.
+type fakeBackend struct {
+	mu        sync.Mutex
+	pipelines map[*fakePipeline]struct{}
+	durations map[string]time.Duration
+	failures  map[string]error
+	title     string
+}
+
+func newFakeBackend() *fakeBackend {
+	return &fakeBackend{
+		pipelines: map[*fakePipeline]struct{}{},
+		durations: map[string]time.Duration{},
+		failures:  map[string]error{},
+	}
+}
+
+// setDuration sets the duration of the given location.
+func (fb *fakeBackend) setDuration(location string, d time.Duration) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	fb.durations[location] = d
+}
+
+// setFailure makes the given location fail to play with the given error.
+func (fb *fakeBackend) setFailure(location string, err error) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	fb.failures[location] = err
+}
+
+// durationOf returns the duration of the given location, which for a
+// virtual track is the length of its range.
+func (fb *fakeBackend) durationOf(location string) int64 {
+	source, start, end, ok := cuesheet.SplitLocation(location)
+	if ok && end > 0 {
+		return end - start
+	}
+
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	d, found := fb.durations[source]
+	if !found {
+		d = fakeDefaultDuration
+	}
+	return max(0, int64(d)-start)
+}
+
+func (fb *fakeBackend) failureOf(location string) error {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	return fb.failures[location]
+}
+
+// advance moves the virtual clock forward, for every pipeline that is
+// playing.
+func (fb *fakeBackend) advance(d time.Duration) {
+	fb.mu.Lock()
+	list := make([]*fakePipeline, 0, len(fb.pipelines))
+	for p := range fb.pipelines {
+		list = append(list, p)
+	}
+	fb.mu.Unlock()
+
+	for _, p := range list {
+		p.advance(d)
+	}
+}
+
+// tick advances the virtual clock along the real one.
+func (fb *fakeBackend) tick(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for range t.C {
+		fb.advance(interval)
+	}
+}
+
+func (fb *fakeBackend) NewPipeline(handle func(Message)) (Pipeline, error) {
+	return &fakePipeline{
+		fb:     fb,
+		handle: handle,
+		msgs:   make(chan Message, fakeMessageBacklog),
+		quit:   make(chan struct{}),
+		state:  StateNull,
+		rate:   1,
+		volume: 1,
+		gain:   1,
+	}, nil
+}
+
+func (fb *fakeBackend) Discover(location string) (*discover.Info, error) {
+	return &discover.Info{
+		Duration: fb.durationOf(location),
+		Seekable: true,
+		URI:      location,
+	}, nil
+}
+
+func (fb *fakeBackend) HasOutputSink(sink string) bool {
+	return false
+}
+
+func (fb *fakeBackend) ListOutputDevices() []OutputDevice {
+	return nil
+}
+
+func (fb *fakeBackend) StartBroadcast(format string, bitrate int) error {
+	return errors.New("broadcast is not supported by the fake backend")
+}
+
+func (fb *fakeBackend) StopBroadcast() {}
+
+func (fb *fakeBackend) BroadcastStatus() BroadcastStatus {
+	return BroadcastStatus{}
+}
+
+func (fb *fakeBackend) SetBroadcastTitle(title string) {
+	fb.mu.Lock()
+	defer fb.mu.Unlock()
+	fb.title = title
+}
+
+// fakePipeline implements the Pipeline interface for the fake backend.
+type fakePipeline struct {
+	fb       *fakeBackend
+	handle   func(Message)
+	msgs     chan Message
+	quit     chan struct{}
+	quitOnce sync.Once
+
+	mu            sync.Mutex
+	location      string
+	next          string // queued to follow location
+	state         State
+	position      int64
+	duration      int64
+	rate          float64
+	volume        float64
+	mute          bool
+	gain          float64
+	bands         models.EqualizerBands
+	output        OutputDevice
+	aboutToFinish bool // posted for the current stream
+	eos           bool // posted for the current stream
+}
+
+func (p *fakePipeline) SetLocation(location string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state == StateNull {
+		p.location = location
+		p.duration = p.fb.durationOf(location)
+	} else {
+		p.next = location
+	}
+	return nil
+}
+
+func (p *fakePipeline) SetState(state State) error {
+	p.mu.Lock()
+	prevState := p.state
+	p.state = state
+	location := p.location
+	p.mu.Unlock()
+
+	if state == prevState {
+		return nil
+	}
+
+	p.fb.mu.Lock()
+	if state == StateNull {
+		delete(p.fb.pipelines, p)
+	} else {
+		p.fb.pipelines[p] = struct{}{}
+	}
+	p.fb.mu.Unlock()
+
+	p.post(Message{
+		Type:      MessageStateChanged,
+		PrevState: prevState,
+		State:     state,
+	})
+
+	if prevState <= StateReady && state >= StatePaused {
+		p.start(location)
+	}
+	return nil
+}
+
+func (p *fakePipeline) Position() (int64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state < StatePaused {
+		return 0, false
+	}
+	return p.position, true
+}
+
+func (p *fakePipeline) Duration() (int64, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.duration, p.duration > 0
+}
+
+func (p *fakePipeline) Seekable() (seekable, ok bool) {
+	return true, true
+}
+
+func (p *fakePipeline) SeekTo(position int64, rate float64, accurate bool) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.state < StatePaused {
+		return false
+	}
+	p.position = max(0, min(position, p.duration))
+	p.rate = rate
+	return true
+}
+
+func (p *fakePipeline) SetVolume(volume float64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.volume = volume
+	return nil
+}
+
+func (p *fakePipeline) SetMute(mute bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.mute = mute
+	return nil
+}
+
+func (p *fakePipeline) SetGain(factor float64) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.gain = factor
+	return nil
+}
+
+func (p *fakePipeline) SetEqualizer(bands models.EqualizerBands) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.bands = bands
+	return nil
+}
+
+func (p *fakePipeline) SetOutput(od OutputDevice) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.output = od
+	p.next = ""
+	return nil
+}
+
+func (p *fakePipeline) Run() {
+	for {
+		select {
+		case <-p.quit:
+			return
+		case msg := <-p.msgs:
+			p.handle(msg)
+		}
+	}
+}
+
+func (p *fakePipeline) Quit() {
+	p.quitOnce.Do(func() { close(p.quit) })
+}
+
+// advance moves the current stream forward, as if the given time went by
+// while playing. Reaching the end of the stream starts the queued one, if
+// any, or posts EOS.
+func (p *fakePipeline) advance(d time.Duration) {
+	p.mu.Lock()
+	if p.state != StatePlaying || p.eos {
+		p.mu.Unlock()
+		return
+	}
+	p.position = min(p.position+int64(float64(d)*p.rate), p.duration)
+	ended := p.position >= p.duration
+	aboutToFinish := ended && !p.aboutToFinish
+	if aboutToFinish {
+		p.aboutToFinish = true
+	}
+	p.mu.Unlock()
+
+	if !ended {
+		return
+	}
+
+	// like playbin's signal, this is handled right away, so the handler
+	// can queue the next stream
+	if aboutToFinish {
+		p.handle(Message{Type: MessageAboutToFinish})
+	}
+
+	p.mu.Lock()
+	next := p.next
+	if next == "" {
+		p.eos = true
+		p.mu.Unlock()
+		p.post(Message{Type: MessageEOS})
+		return
+	}
+	p.location = next
+	p.next = ""
+	p.position = 0
+	p.duration = p.fb.durationOf(next)
+	p.aboutToFinish = false
+	p.mu.Unlock()
+
+	p.start(next)
+}
+
+// start posts the messages for the beginning of the given stream.
+func (p *fakePipeline) start(location string) {
+	if err := p.fb.failureOf(location); err != nil {
+		p.post(Message{
+			Type: MessageError,
+			Text: err.Error(),
+			Err:  err,
+		})
+		return
+	}
+	p.post(Message{Type: MessageStreamStart})
+}
+
+func (p *fakePipeline) post(msg Message) {
+	select {
+	case p.msgs <- msg:
+	case <-p.quit:
+	}
+}
@@ -0,0 +1,75 @@
+// Package gstreamer implements the GStreamer playback backend, which is
+// registered as such on import.
+package gstreamer
+
+import (
+	"github.com/go-gst/go-gst/gst"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
+	"github.com/jwmwalrus/m3u-etcetera/internal/discover"
+	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+)
+
+func init() {
+	playback.RegisterBackend(config.PlaybackBackendGStreamer, New)
+}
+
+// backend implements the playback.Backend interface.
+type backend struct {
+	broadcast *broadcaster
+}
+
+// New returns a new GStreamer backend.
+func New() (playback.Backend, error) {
+	gst.Init(nil)
+
+	return &backend{broadcast: newBroadcaster()}, nil
+}
+
+func (b *backend) NewPipeline(handle func(playback.Message)) (playback.Pipeline, error) {
+	p, err := newPipeline(b, handle)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (b *backend) Discover(location string) (*discover.Info, error) {
+	source, start, end, ok := cuesheet.SplitLocation(location)
+	info, err := discover.Execute(source)
+	if err != nil || !ok {
+		return info, err
+	}
+
+	info.URI = location
+	if end > 0 {
+		info.Duration = end - start
+	} else {
+		info.Duration = max(0, info.Duration-start)
+	}
+	return info, nil
+}
+
+func (b *backend) HasOutputSink(sink string) bool {
+	return gst.Find(sink) != nil
+}
+
+func (b *backend) ListOutputDevices() []playback.OutputDevice {
+	return listOutputDevices()
+}
+
+func (b *backend) StartBroadcast(format string, bitrate int) error {
+	return b.broadcast.start(format, bitrate)
+}
+
+func (b *backend) StopBroadcast() {
+	b.broadcast.stop()
+}
+
+func (b *backend) BroadcastStatus() playback.BroadcastStatus {
+	return b.broadcast.status()
+}
+
+func (b *backend) SetBroadcastTitle(title string) {
+	b.broadcast.setTitle(title)
+}
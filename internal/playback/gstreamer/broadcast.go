@@ -0,0 +1,446 @@
+package gstreamer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/go-gst/go-gst/gst"
+	"github.com/go-gst/go-gst/gst/app"
+	"github.com/jwmwalrus/bnp/onerror"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+)
+
+const (
+	broadcastSrcName = "m3uetc-broadcast-src"
+	broadcastOutName = "m3uetc-broadcast-out"
+	broadcastTapName = "m3uetc-broadcast-tap"
+	outputTeeName    = "m3uetc-output-tee"
+	outputQueueName  = "m3uetc-output-queue"
+
+	broadcastCaps = "audio/x-raw,format=S16LE,rate=44100,channels=2,layout=interleaved"
+
+	// broadcastTapDesc describes the audio sink used while broadcasting,
+	// which feeds both the output sink, linked to the queue, and the
+	// broadcast encoder.
+	broadcastTapDesc = "tee name=" + outputTeeName + " ! queue name=" + outputQueueName + " " +
+		outputTeeName + ". ! queue leaky=downstream ! audioconvert ! audioresample ! " +
+		broadcastCaps + " ! appsink name=" + broadcastTapName + " sync=false async=false"
+
+	// broadcastMetaInt defines the number of audio bytes between ICY
+	// metadata blocks.
+	broadcastMetaInt = 16000
+
+	// broadcastBacklog defines how many encoded chunks a listener can lag
+	// behind before being dropped.
+	broadcastBacklog = 256
+)
+
+type broadcastListener struct {
+	ch chan []byte
+}
+
+// broadcaster encodes the audio being played and serves it over HTTP,
+// Icecast style.
+type broadcaster struct {
+	mu        sync.Mutex
+	pipeline  *gst.Pipeline
+	src       *app.Source
+	server    *http.Server
+	format    string
+	bitrate   int
+	url       string
+	title     string
+	headers   []byte // stream headers, sent first to every listener
+	listeners map[*broadcastListener]struct{}
+}
+
+func newBroadcaster() *broadcaster {
+	return &broadcaster{listeners: map[*broadcastListener]struct{}{}}
+}
+
+func (b *broadcaster) isActive() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.pipeline != nil
+}
+
+func (b *broadcaster) status() playback.BroadcastStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return playback.BroadcastStatus{
+		Active:    b.pipeline != nil,
+		Format:    b.format,
+		Bitrate:   b.bitrate,
+		URL:       b.url,
+		Listeners: len(b.listeners),
+		Title:     b.title,
+	}
+}
+
+func (b *broadcaster) setTitle(title string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.title = title
+}
+
+func (b *broadcaster) getTitle() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.title
+}
+
+// start starts encoding and serving the broadcast.
+func (b *broadcaster) start(format string, bitrate int) (err error) {
+	if b.isActive() {
+		return errors.New("broadcast is already active")
+	}
+
+	conf := base.Conf.Server.Broadcast
+	if format == "" {
+		format = conf.Format
+	}
+	if bitrate <= 0 {
+		bitrate = conf.Bitrate
+	}
+
+	var encoder, contentType string
+	switch format {
+	case config.BroadcastOpus:
+		encoder = "opusenc bitrate=" + strconv.Itoa(bitrate*1000) + " ! oggmux"
+		contentType = "audio/ogg"
+	case config.BroadcastMP3:
+		encoder = "lamemp3enc target=bitrate cbr=true bitrate=" + strconv.Itoa(bitrate)
+		contentType = "audio/mpeg"
+	default:
+		return fmt.Errorf("unsupported broadcast format: %v", format)
+	}
+
+	logw := slog.With(
+		"format", format,
+		"bitrate", bitrate,
+	)
+	logw.Info("Starting broadcast")
+
+	pipeline, err := gst.NewPipelineFromString(
+		"appsrc name=" + broadcastSrcName + " is-live=true do-timestamp=true format=time caps=" + broadcastCaps +
+			" ! audioconvert ! audioresample ! " + encoder +
+			" ! appsink name=" + broadcastOutName + " sync=false",
+	)
+	if err != nil {
+		return
+	}
+
+	srcEl, err := pipeline.GetElementByName(broadcastSrcName)
+	if err != nil {
+		return
+	}
+	outEl, err := pipeline.GetElementByName(broadcastOutName)
+	if err != nil {
+		return
+	}
+	app.SinkFromElement(outEl).SetCallbacks(&app.SinkCallbacks{
+		NewSampleFunc: b.onEncodedSample,
+	})
+
+	ln, err := net.Listen("tcp", net.JoinHostPort(conf.Host, strconv.Itoa(conf.Port)))
+	if err != nil {
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		b.serveListener(w, r, contentType)
+	})
+	server := &http.Server{Handler: mux}
+
+	host := conf.Host
+	if host == "" {
+		host, _ = os.Hostname()
+	}
+
+	b.mu.Lock()
+	if b.pipeline != nil {
+		b.mu.Unlock()
+		onerror.Log(ln.Close())
+		return errors.New("broadcast is already active")
+	}
+	b.pipeline = pipeline
+	b.src = app.SrcFromElement(srcEl)
+	b.server = server
+	b.format = format
+	b.bitrate = bitrate
+	b.url = "http://" + net.JoinHostPort(host, strconv.Itoa(conf.Port)) + "/"
+	b.headers = nil
+	b.mu.Unlock()
+
+	go func() {
+		if err := server.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logw.Error("Broadcast server failed", "error", err)
+		}
+	}()
+
+	if err = pipeline.SetState(gst.StatePlaying); err != nil {
+		b.stop()
+		return
+	}
+
+	logw.Info("Broadcast started", "url", b.status().URL)
+	return
+}
+
+// stop stops the broadcast and disconnects all listeners.
+func (b *broadcaster) stop() {
+	b.mu.Lock()
+	pipeline, src, server := b.pipeline, b.src, b.server
+	if pipeline == nil {
+		b.mu.Unlock()
+		return
+	}
+
+	for l := range b.listeners {
+		close(l.ch)
+		delete(b.listeners, l)
+	}
+
+	b.pipeline = nil
+	b.src = nil
+	b.server = nil
+	b.url = ""
+	b.headers = nil
+	b.mu.Unlock()
+
+	slog.Info("Stopping broadcast")
+
+	// the encoder's streaming thread takes the lock, so the pipeline has
+	// to be stopped without holding it
+	src.EndStream()
+	onerror.Log(pipeline.SetState(gst.StateNull))
+	onerror.Log(server.Close())
+}
+
+// push feeds the encoder with raw audio from the tap.
+func (b *broadcaster) push(data []byte) {
+	b.mu.Lock()
+	src := b.src
+	b.mu.Unlock()
+
+	if src == nil {
+		return
+	}
+	src.PushBuffer(gst.NewBufferFromBytes(data))
+}
+
+func (b *broadcaster) onEncodedSample(sink *app.Sink) gst.FlowReturn {
+	sample := sink.PullSample()
+	if sample == nil {
+		return gst.FlowEOS
+	}
+	buf := sample.GetBuffer()
+	if buf == nil {
+		return gst.FlowOK
+	}
+	data := buf.Bytes()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if buf.HasFlags(gst.BufferFlagHeader) {
+		b.headers = append(b.headers, data...)
+	}
+
+	for l := range b.listeners {
+		select {
+		case l.ch <- data:
+		default:
+			slog.Warn("Dropping slow broadcast listener")
+			close(l.ch)
+			delete(b.listeners, l)
+		}
+	}
+	return gst.FlowOK
+}
+
+func (b *broadcaster) serveListener(w http.ResponseWriter, r *http.Request,
+	contentType string) {
+
+	b.mu.Lock()
+	if b.pipeline == nil {
+		b.mu.Unlock()
+		http.Error(w, "Broadcast is not active", http.StatusServiceUnavailable)
+		return
+	}
+	l := &broadcastListener{ch: make(chan []byte, broadcastBacklog)}
+	b.listeners[l] = struct{}{}
+	headers := slices.Clone(b.headers)
+	bitrate := b.bitrate
+	b.mu.Unlock()
+
+	logw := slog.With("remote", r.RemoteAddr)
+	logw.Info("Broadcast listener connected")
+
+	defer func() {
+		b.mu.Lock()
+		delete(b.listeners, l)
+		b.mu.Unlock()
+		logw.Info("Broadcast listener disconnected")
+	}()
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache, no-store")
+	w.Header().Set("icy-name", base.AppName)
+	w.Header().Set("icy-br", strconv.Itoa(bitrate))
+
+	iw := &icyWriter{w: w, title: b.getTitle}
+	if r.Header.Get("Icy-MetaData") == "1" {
+		iw.metaint = broadcastMetaInt
+		iw.remaining = broadcastMetaInt
+		w.Header().Set("icy-metaint", strconv.Itoa(broadcastMetaInt))
+	}
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+
+	if _, err := iw.Write(headers); err != nil {
+		return
+	}
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case data, ok := <-l.ch:
+			if !ok {
+				return
+			}
+			if _, err := iw.Write(data); err != nil {
+				return
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// icyWriter interleaves ICY metadata blocks into the audio sent to a
+// listener, every metaint bytes.
+type icyWriter struct {
+	w         io.Writer
+	metaint   int
+	remaining int
+	title     func() string
+	lastTitle string
+}
+
+func (iw *icyWriter) Write(p []byte) (n int, err error) {
+	if iw.metaint == 0 {
+		return iw.w.Write(p)
+	}
+
+	for len(p) > 0 {
+		if iw.remaining == 0 {
+			if _, err = iw.w.Write(iw.metadata()); err != nil {
+				return
+			}
+			iw.remaining = iw.metaint
+		}
+
+		k := min(len(p), iw.remaining)
+		var m int
+		m, err = iw.w.Write(p[:k])
+		n += m
+		if err != nil {
+			return
+		}
+		iw.remaining -= k
+		p = p[k:]
+	}
+	return
+}
+
+// metadata returns the next metadata block, which is empty unless the
+// title changed since the last one.
+func (iw *icyWriter) metadata() []byte {
+	title := iw.title()
+	if title == iw.lastTitle {
+		return []byte{0}
+	}
+	iw.lastTitle = title
+
+	meta := "StreamTitle='" + title + "';"
+	if len(meta) > 255*16 {
+		meta = meta[:255*16]
+	}
+	size := (len(meta) + 15) / 16
+	block := make([]byte, 1+size*16)
+	block[0] = byte(size)
+	copy(block[1:], meta)
+	return block
+}
+
+// newTap returns an audio sink that feeds both the given sink, or
+// the default one if nil, and the broadcast.
+func (b *broadcaster) newTap(sink *gst.Element) (*gst.Element, error) {
+	if sink == nil {
+		var err error
+		sink, err = gst.NewElementWithName("autoaudiosink", outputSinkName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	bin, err := gst.NewBinFromString(broadcastTapDesc, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bin.Add(sink); err != nil {
+		return nil, err
+	}
+	queue, err := bin.GetElementByName(outputQueueName)
+	if err != nil {
+		return nil, err
+	}
+	if err := queue.Link(sink); err != nil {
+		return nil, err
+	}
+
+	tee, err := bin.GetElementByName(outputTeeName)
+	if err != nil {
+		return nil, err
+	}
+	ghost := gst.NewGhostPad("sink", tee.GetStaticPad("sink"))
+	if ghost == nil || !bin.AddPad(ghost.Pad) {
+		return nil, errors.New("failed to add sink pad to broadcast tap")
+	}
+
+	tapEl, err := bin.GetElementByName(broadcastTapName)
+	if err != nil {
+		return nil, err
+	}
+	app.SinkFromElement(tapEl).SetCallbacks(&app.SinkCallbacks{
+		NewSampleFunc: func(tap *app.Sink) gst.FlowReturn {
+			sample := tap.PullSample()
+			if sample == nil {
+				return gst.FlowEOS
+			}
+			if buf := sample.GetBuffer(); buf != nil {
+				b.push(buf.Bytes())
+			}
+			return gst.FlowOK
+		},
+	})
+
+	return bin.Element, nil
+}
@@ -0,0 +1,59 @@
+package gstreamer
+
+import (
+	"log/slog"
+
+	"github.com/go-gst/go-gst/gst"
+)
+
+const (
+	gainFilterName      = "m3uetc-replaygain"
+	equalizerFilterName = "m3uetc-equalizer"
+
+	// audioFilterDesc describes the playbin's audio filter, which keeps
+	// the pitch when the rate changes and applies ReplayGain independently
+	// of the playbin's own volume.
+	audioFilterDesc = "scaletempo ! audioconvert ! audioresample ! volume name=" + gainFilterName
+
+	// equalizerFilterDesc extends audioFilterDesc with a ten-band
+	// equalizer, placed before the ReplayGain volume.
+	equalizerFilterDesc = "scaletempo ! audioconvert ! audioresample ! equalizer-10bands name=" +
+		equalizerFilterName + " ! volume name=" + gainFilterName
+)
+
+// newAudioFilter returns the element to be used as the playbin's audio
+// filter, along with the elements that apply ReplayGain and equalization.
+// The equalizer is nil if it is not available.
+func newAudioFilter() (filter, gain, eq *gst.Element) {
+	bin, err := gst.NewBinFromString(equalizerFilterDesc, true)
+	if err == nil {
+		gain, err = bin.GetElementByName(gainFilterName)
+		if err == nil {
+			eq, err = bin.GetElementByName(equalizerFilterName)
+			if err == nil {
+				filter = bin.Element
+				return
+			}
+		}
+	}
+	slog.Warn("Failed to create equalizer, equalization is not available", "error", err)
+	eq = nil
+
+	bin, err = gst.NewBinFromString(audioFilterDesc, true)
+	if err == nil {
+		gain, err = bin.GetElementByName(gainFilterName)
+		if err == nil {
+			filter = bin.Element
+			return
+		}
+	}
+	slog.Warn("Failed to create audio filter, pitch correction is not available", "error", err)
+
+	gain, err = gst.NewElementWithName("volume", gainFilterName)
+	if err != nil {
+		slog.Error("Failed to create ReplayGain filter", "error", err)
+		return
+	}
+	filter = gain
+	return
+}
@@ -0,0 +1,136 @@
+package gstreamer
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/go-gst/go-gst/gst"
+	"github.com/jwmwalrus/bnp/onerror"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+)
+
+const (
+	outputSinkName = "m3uetc-output"
+
+	// outputFileDesc describes the file sink, which writes raw
+	// S16LE/44100/stereo audio, appending consecutive streams.
+	outputFileDesc = "audioconvert ! audioresample ! audio/x-raw,format=S16LE,rate=44100,channels=2 ! filesink sync=true append=true name=" + outputSinkName
+
+	// outputSwitchTimeout defines how long to wait for the pipeline to
+	// preroll after switching the output.
+	outputSwitchTimeout = 5 * time.Second
+)
+
+// outputDeviceProperty maps a sink to the property that selects its device.
+var outputDeviceProperty = map[string]string{
+	"alsasink":        "device",
+	"directsoundsink": "device",
+	"oss4sink":        "device",
+	"osssink":         "device",
+	"pipewiresink":    "target-object",
+	"pulsesink":       "device",
+	"wasapi2sink":     "device",
+	"wasapisink":      "device",
+}
+
+// listOutputDevices returns the outputs reported by the GStreamer device
+// monitor.
+func listOutputDevices() (list []playback.OutputDevice) {
+	mon := gst.NewDeviceMonitor()
+	if mon == nil {
+		slog.Error("Failed to create device monitor")
+		return
+	}
+	mon.AddFilter("Audio/Sink", gst.NewAnyCaps())
+	if !mon.Start() {
+		slog.Error("Failed to start device monitor")
+		return
+	}
+	defer mon.Stop()
+
+	for _, dev := range mon.GetDevices() {
+		sink := dev.CreateElement("")
+		if sink == nil {
+			continue
+		}
+
+		od := playback.OutputDevice{Name: dev.GetDisplayName()}
+		if f := sink.GetFactory(); f != nil {
+			od.Sink = f.GetName()
+		}
+		if prop := outputDeviceProperty[od.Sink]; prop != "" {
+			if v, err := sink.GetProperty(prop); err == nil && v != nil {
+				od.Device = fmt.Sprint(v)
+			}
+		}
+		if od.Sink == "" {
+			continue
+		}
+		list = append(list, od)
+	}
+	return
+}
+
+// newOutputSink returns the element to be used as the playbin's audio
+// sink, or nil if playbin should choose its own.
+func newOutputSink(od playback.OutputDevice) *gst.Element {
+	logw := slog.With("output", od)
+
+	switch od.Sink {
+	case "", config.OutputSinkAuto:
+		return nil
+	case config.OutputSinkFake:
+		sink, err := gst.NewElementWithName("fakesink", outputSinkName)
+		if err != nil {
+			logw.Error("Failed to create output sink", "error", err)
+			return nil
+		}
+		onerror.Log(sink.Set("sync", true))
+		return sink
+	case config.OutputSinkFile:
+		bin, err := gst.NewBinFromString(outputFileDesc, true)
+		if err != nil {
+			logw.Error("Failed to create output sink", "error", err)
+			return nil
+		}
+		location := od.Device
+		if location == "" {
+			location = playback.DefaultOutputFile()
+		}
+		if sink, err := bin.GetElementByName(outputSinkName); err == nil {
+			onerror.Log(sink.Set("location", location))
+		}
+		return bin.Element
+	}
+
+	sink, err := gst.NewElementWithName(od.Sink, outputSinkName)
+	if err != nil {
+		logw.Error("Failed to create output sink", "error", err)
+		return nil
+	}
+	if prop := outputDeviceProperty[od.Sink]; prop != "" && od.Device != "" {
+		if err := sink.Set(prop, od.Device); err != nil {
+			logw.Warn("Failed to select output device", "error", err)
+		}
+	}
+	return sink
+}
+
+// newAudioSink returns the element to be used as the playbin's audio sink,
+// tapped for the broadcast if it is active, or nil if playbin should
+// choose its own.
+func (b *backend) newAudioSink(od playback.OutputDevice) *gst.Element {
+	sink := newOutputSink(od)
+	if !b.broadcast.isActive() {
+		return sink
+	}
+
+	tap, err := b.broadcast.newTap(sink)
+	if err != nil {
+		slog.Error("Failed to create broadcast tap", "error", err)
+		return sink
+	}
+	return tap
+}
@@ -0,0 +1,360 @@
+package gstreamer
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-gst/go-glib/glib"
+	"github.com/go-gst/go-gst/gst"
+	"github.com/jwmwalrus/bnp/onerror"
+	"github.com/jwmwalrus/m3u-etcetera/internal/cuesheet"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/playback"
+)
+
+// tagList implements the playback.TagList interface.
+type tagList struct {
+	*gst.TagList
+}
+
+func (tl tagList) GetString(tag string) (string, bool) {
+	return tl.TagList.GetString(gst.Tag(tag))
+}
+
+func (tl tagList) GetUint32(tag string) (uint32, bool) {
+	return tl.TagList.GetUint32(gst.Tag(tag))
+}
+
+// pipeline implements the playback.Pipeline interface, over a playbin.
+type pipeline struct {
+	b        *backend
+	playbin  *gst.Element
+	gain     *gst.Element
+	eq       *gst.Element // nil if equalization is not available
+	mainLoop *glib.MainLoop
+	handle   func(playback.Message)
+	state    atomic.Int32
+	rate     atomic.Uint64 // float64 bits
+
+	mu       sync.Mutex
+	location string
+	next     string // queued to follow location
+}
+
+// span returns the range of the current location, if it belongs to a
+// virtual track, and whether there is one.
+func (p *pipeline) span() (start, end int64, ok bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	_, start, end, ok = cuesheet.SplitLocation(p.location)
+	return
+}
+
+func newPipeline(b *backend, handle func(playback.Message)) (*pipeline, error) {
+	playbin, err := gst.NewElementWithName("playbin", "m3uetc-playbin")
+	if err != nil {
+		return nil, err
+	}
+	slog.Debug("Playbin created")
+
+	p := &pipeline{
+		b:        b,
+		playbin:  playbin,
+		mainLoop: glib.NewMainLoop(glib.MainContextDefault(), false),
+		handle:   handle,
+	}
+	p.state.Store(int32(playback.StateNull))
+	p.rate.Store(math.Float64bits(1))
+
+	p.setFlags()
+
+	if filter, gain, eq := newAudioFilter(); filter != nil {
+		if err := playbin.Set("audio-filter", filter); err != nil {
+			slog.Warn("Unable to set audio filter", "error", err)
+		} else {
+			p.gain = gain
+			p.eq = eq
+		}
+	}
+
+	_, err = playbin.Connect("about-to-finish", func() {
+		handle(playback.Message{Type: playback.MessageAboutToFinish})
+	})
+	if err != nil {
+		slog.Warn("Unable to connect to about-to-finish signal", "error", err)
+	}
+	return p, nil
+}
+
+func (p *pipeline) SetLocation(location string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if playback.State(p.state.Load()) == playback.StateNull {
+		p.location = location
+	} else {
+		p.next = location
+	}
+	return p.playbin.Set("uri", cuesheet.Source(location))
+}
+
+// SetState sets the state of the playbin. A virtual track is prerolled
+// first, so its range can be applied before it starts playing.
+func (p *pipeline) SetState(state playback.State) error {
+	prevState := playback.State(p.state.Load())
+	if _, _, ok := p.span(); ok &&
+		prevState <= playback.StateReady &&
+		state >= playback.StatePaused {
+
+		if err := p.playbin.SetState(gst.StatePaused); err != nil {
+			return err
+		}
+		p.state.Store(int32(playback.StatePaused))
+		p.playbin.GetState(gst.StatePaused, gst.ClockTime(outputSwitchTimeout))
+		p.seekSpan(0, true)
+	}
+
+	if err := p.playbin.SetState(gst.State(state)); err != nil {
+		return err
+	}
+	p.state.Store(int32(state))
+	return nil
+}
+
+// Position returns the position of the stream, relative to the start of
+// its range, if any.
+func (p *pipeline) Position() (int64, bool) {
+	ok, position := p.playbin.QueryPosition(gst.FormatTime)
+	if start, _, isSpan := p.span(); ok && isSpan {
+		position = max(0, position-start)
+	}
+	return position, ok
+}
+
+// Duration returns the duration of the stream, which is the length of
+// its range, if any.
+func (p *pipeline) Duration() (int64, bool) {
+	start, end, isSpan := p.span()
+	if isSpan && end > 0 {
+		return end - start, true
+	}
+
+	ok, duration := p.playbin.QueryDuration(gst.FormatTime)
+	if ok && isSpan {
+		duration = max(0, duration-start)
+	}
+	return duration, ok
+}
+
+func (p *pipeline) Seekable() (seekable, ok bool) {
+	q := gst.NewSeekingQuery(gst.FormatTime)
+	if !p.playbin.Query(q) {
+		slog.Debug("Seeking query failed")
+		return
+	}
+
+	format, seekable, start, end := q.ParseSeeking()
+	slog.With(
+		"format", format,
+		"seekable", seekable,
+		"start", start,
+		"end", end,
+	).
+		Debug("Seeking query succeeded")
+	return seekable, true
+}
+
+func (p *pipeline) SeekTo(position int64, rate float64, accurate bool) bool {
+	p.rate.Store(math.Float64bits(rate))
+	return p.seekSpan(position, accurate)
+}
+
+// seekSpan seeks the given position, relative to the start of the
+// current range, if any, which also bounds the end of the stream.
+func (p *pipeline) seekSpan(position int64, accurate bool) bool {
+	flags := gst.SeekFlagFlush | gst.SeekFlagKeyUnit
+	if accurate {
+		flags = gst.SeekFlagFlush | gst.SeekFlagAccurate
+	}
+
+	stopType, stop := gst.SeekTypeNone, int64(-1)
+	start, end, _ := p.span()
+	if end > 0 {
+		stopType, stop = gst.SeekTypeSet, end
+	}
+
+	seek := gst.NewSeekEvent(
+		math.Float64frombits(p.rate.Load()),
+		gst.FormatTime,
+		flags,
+		gst.SeekTypeSet,
+		start+position,
+		stopType,
+		stop,
+	)
+	return p.playbin.SendEvent(seek)
+}
+
+func (p *pipeline) SetVolume(volume float64) error {
+	return p.playbin.Set("volume", volume)
+}
+
+func (p *pipeline) SetMute(mute bool) error {
+	return p.playbin.Set("mute", mute)
+}
+
+func (p *pipeline) SetGain(factor float64) error {
+	if p.gain == nil {
+		return nil
+	}
+	return p.gain.Set("volume", factor)
+}
+
+func (p *pipeline) SetEqualizer(bands models.EqualizerBands) error {
+	if p.eq == nil {
+		return nil
+	}
+
+	for i, g := range bands {
+		if err := p.eq.Set(fmt.Sprintf("band%d", i), g); err != nil {
+			return fmt.Errorf("band %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// SetOutput replaces the playbin's audio sink. If the playbin is running,
+// it is taken back to the ready state, so the current stream starts over,
+// and then to its previous position and state.
+func (p *pipeline) SetOutput(od playback.OutputDevice) error {
+	sink := p.b.newAudioSink(od)
+
+	state := playback.State(p.state.Load())
+	if state == playback.StateNull {
+		if sink == nil {
+			return nil
+		}
+		return p.playbin.Set("audio-sink", sink)
+	}
+
+	if sink == nil {
+		var err error
+		sink, err = gst.NewElementWithName("autoaudiosink", outputSinkName)
+		if err != nil {
+			return err
+		}
+	}
+
+	position, _ := p.Position()
+
+	p.mu.Lock()
+	p.next = ""
+	location := p.location
+	p.mu.Unlock()
+	onerror.Log(p.playbin.Set("uri", cuesheet.Source(location)))
+
+	onerror.Log(p.playbin.SetState(gst.StateReady))
+	err := p.playbin.Set("audio-sink", sink)
+	onerror.Log(p.playbin.SetState(gst.StatePaused))
+	p.playbin.GetState(gst.StatePaused, gst.ClockTime(outputSwitchTimeout))
+
+	_, _, isSpan := p.span()
+	if seekable, _ := p.Seekable(); seekable && (position > 0 || isSpan) {
+		p.seekSpan(position, true)
+	}
+	if state == playback.StatePlaying {
+		onerror.Log(p.playbin.SetState(gst.StatePlaying))
+	}
+	return err
+}
+
+func (p *pipeline) Run() {
+	bus := p.playbin.GetBus()
+	bus.AddWatch(p.handleBusMessage)
+
+	p.mainLoop.Run()
+
+	bus.RemoveWatch()
+}
+
+func (p *pipeline) Quit() {
+	p.mainLoop.Quit()
+}
+
+func (p *pipeline) handleBusMessage(msg *gst.Message) bool {
+	m := playback.Message{Text: msg.String()}
+
+	switch msg.Type() {
+	case gst.MessageEOS:
+		m.Type = playback.MessageEOS
+	case gst.MessageError:
+		m.Type = playback.MessageError
+		if gerr := msg.ParseError(); gerr != nil {
+			m.Err = gerr
+		}
+	case gst.MessageWarning:
+		m.Type = playback.MessageWarning
+	case gst.MessageInfo:
+		m.Type = playback.MessageInfo
+	case gst.MessageStateChanged:
+		m.Type = playback.MessageStateChanged
+		prevState, state := msg.ParseStateChanged()
+		m.PrevState = playback.State(prevState)
+		m.State = playback.State(state)
+	case gst.MessageStreamStart:
+		m.Type = playback.MessageStreamStart
+		p.mu.Lock()
+		promoted := p.next != ""
+		if promoted {
+			p.location, p.next = p.next, ""
+		}
+		p.mu.Unlock()
+
+		// a queued virtual track starts at the beginning of the file
+		if _, _, isSpan := p.span(); promoted && isSpan {
+			p.seekSpan(0, true)
+		}
+	case gst.MessageDurationChanged:
+		m.Type = playback.MessageDurationChanged
+	case gst.MessageTag:
+		m.Type = playback.MessageTag
+		if tags := msg.ParseTags(); tags != nil {
+			m.Tags = tagList{tags}
+		}
+	case gst.MessageBuffering:
+		m.Type = playback.MessageBuffering
+		m.Percent = msg.ParseBuffering()
+	default:
+	}
+
+	p.handle(m)
+	return true
+}
+
+// setFlags makes the playbin play audio only.
+func (p *pipeline) setFlags() {
+	flags, err := p.playbin.GetProperty("flags")
+	if err != nil {
+		slog.Error("Unable to get flags", "error", err)
+		return
+	}
+
+	eflags := flags.(uint)
+	eflags = eflags &^ (1 << 0) // no video
+	eflags = eflags | (1 << 1)  // yes audio
+	eflags = eflags &^ (1 << 2) // no text
+	p.playbin.SetArg("flags", strconv.FormatInt(int64(eflags), 10))
+	fflags, _ := p.playbin.GetProperty("flags")
+	if fflags.(uint) != eflags {
+		slog.With(
+			"initialFlags", flags,
+			"expectedFlags", eflags,
+			"finalFlags", fflags,
+		).Warn("Flags could not be set")
+	}
+}
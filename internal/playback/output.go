@@ -0,0 +1,104 @@
+package playback
+
+import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	rtc "github.com/jwmwalrus/rtcycler"
+)
+
+// outputFilename defines the default file written by the file sink.
+const outputFilename = "output.raw"
+
+// OutputDevice defines an output the playback can be routed to.
+type OutputDevice struct {
+	Sink   string // auto, fake, file or a backend's sink (e.g., pulsesink)
+	Device string // sink's device or, for the file sink, the output path
+	Name   string // human-readable name
+}
+
+// IsAuto returns true if the output is the one chosen by the backend.
+func (od OutputDevice) IsAuto() bool {
+	return od.Sink == "" || od.Sink == config.OutputSinkAuto
+}
+
+func (od OutputDevice) String() string {
+	if od.Device == "" {
+		return od.Sink
+	}
+	return od.Sink + ":" + od.Device
+}
+
+// getOutputDevice returns the chosen output, or the configured one if none
+// was chosen.
+func getOutputDevice() OutputDevice {
+	ps := models.GetPlaybackSettings()
+	if ps.OutputSink != "" {
+		return OutputDevice{Sink: ps.OutputSink, Device: ps.OutputDevice}
+	}
+
+	out := base.Conf.Server.Playback.Output
+	return OutputDevice{Sink: out.Sink, Device: out.Device}
+}
+
+// listOutputDevices returns the available outputs, as reported by the
+// backend, along with the ones that are always available.
+func (e *engine) listOutputDevices() []OutputDevice {
+	list := []OutputDevice{
+		{Sink: config.OutputSinkAuto, Name: "Automatic"},
+		{Sink: config.OutputSinkFake, Name: "Null output"},
+		{Sink: config.OutputSinkFile, Device: DefaultOutputFile(), Name: "Raw audio file"},
+	}
+	return append(list, e.backend.ListOutputDevices()...)
+}
+
+// setOutputDevice persists the given output and, if a stream is running,
+// switches to it right away.
+func (e *engine) setOutputDevice(od OutputDevice) error {
+	slog.Info("Setting output device", "output", od)
+
+	if !od.IsAuto() && od.Sink != config.OutputSinkFake && od.Sink != config.OutputSinkFile {
+		if !e.backend.HasOutputSink(od.Sink) {
+			return fmt.Errorf("unknown output sink: %v", od.Sink)
+		}
+	}
+
+	ps := models.GetPlaybackSettings()
+	ps.OutputSink = od.Sink
+	ps.OutputDevice = od.Device
+	if err := ps.Save(); err != nil {
+		return err
+	}
+
+	go e.switchOutput(od)
+	return nil
+}
+
+// switchOutput routes the running pipeline to the given output, keeping
+// the current position and state.
+func (e *engine) switchOutput(od OutputDevice) {
+	pl := e.pipeline.Load()
+	pb := e.pb.Load()
+	if pl == nil || pb == nil {
+		return
+	}
+
+	slog.Info("Switching output", "output", od, "position", e.getPosition())
+
+	// the pipeline drops the queued stream, if any
+	e.discardNextStream()
+
+	if err := pl.SetOutput(od); err != nil {
+		slog.Error("Failed to switch output", "error", err)
+	}
+}
+
+// DefaultOutputFile returns the file written by the file sink, unless
+// another one is chosen.
+func DefaultOutputFile() string {
+	return filepath.Join(rtc.DataDir(), outputFilename)
+}
@@ -1,11 +1,18 @@
 package playback
 
 import (
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/godbus/dbus/v5"
 	"github.com/godbus/dbus/v5/introspect"
 	"github.com/godbus/dbus/v5/prop"
+	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
 	"github.com/jwmwalrus/m3u-etcetera/internal/mpris"
 )
 
@@ -20,6 +27,7 @@ const (
 type Player struct {
 	*mpris.Instance
 	lastPlaybackStatus string
+	lastPlaybackID     int64
 }
 
 func (*Player) IntrospectInterface() introspect.Interface {
@@ -29,12 +37,12 @@ func (*Player) IntrospectInterface() introspect.Interface {
 func (p *Player) Properties() map[string]*prop.Prop {
 	return map[string]*prop.Prop{
 		"PlaybackStatus": {Value: p.PlaybackStatus(), Emit: prop.EmitTrue},
-		"LoopStatus":     {Value: "None", Writable: true, Emit: prop.EmitTrue},
-		"Rate":           {Value: float64(1.), Writable: true, Emit: prop.EmitTrue},
-		"Shuffle":        {Value: false, Writable: true, Emit: prop.EmitTrue},
+		"LoopStatus":     {Value: GetEventsInstance().GetRepeatMode().String(), Writable: true, Emit: prop.EmitTrue, Callback: p.onLoopStatusChange},
+		"Rate":           {Value: GetEventsInstance().GetRate(), Writable: true, Emit: prop.EmitTrue, Callback: p.onRateChange},
+		"Shuffle":        {Value: GetEventsInstance().GetShuffle(), Writable: true, Emit: prop.EmitTrue, Callback: p.onShuffleChange},
 		"Metadata":       {Value: p.Metadata(), Emit: prop.EmitTrue},
-		"Volume":         {Value: float64(1.), Writable: true, Emit: prop.EmitTrue},
-		"Position":       {Value: p.Position(), Emit: prop.EmitTrue},
+		"Volume":         {Value: p.currentVolume(), Writable: true, Emit: prop.EmitTrue, Callback: p.onVolumeChange},
+		"Position":       {Value: p.Position(), Emit: prop.EmitFalse},
 		"MinimumRate":    {Value: p.MinimumRate(), Emit: prop.EmitTrue},
 		"MaximumRate":    {Value: p.MaximumRate(), Emit: prop.EmitTrue},
 		"CanGoNext":      {Value: p.CanGoNext(), Emit: prop.EmitTrue},
@@ -89,15 +97,79 @@ func (*Player) Play() *dbus.Error {
 	return nil
 }
 
-func (*Player) Seek(x int64) *dbus.Error {
+func (p *Player) Seek(x int64) *dbus.Error {
+	if !p.CanSeek() {
+		return nil
+	}
+
+	pos := instance.eng.getPosition() + x*int64(time.Microsecond)
+	if pos < 0 {
+		pos = 0
+	}
+	if d := instance.eng.duration.Load(); d > 0 && pos > d {
+		return p.Next()
+	}
+
+	GetEventsInstance().SeekInStream(pos)
 	return nil
 }
 
-func (*Player) SetPosition(o string, x int64) *dbus.Error {
+func (p *Player) SetPosition(o dbus.ObjectPath, x int64) *dbus.Error {
+	if !p.CanSeek() {
+		return nil
+	}
+
+	pb, _ := GetEventsInstance().GetPlayback()
+	if o != trackObjectPath(pb) {
+		return nil
+	}
+
+	pos := x * int64(time.Microsecond)
+	if d := instance.eng.duration.Load(); pos < 0 || (d > 0 && pos > d) {
+		return nil
+	}
+
+	GetEventsInstance().SeekInStream(pos)
 	return nil
 }
 
+// OpenUri plays the given file or stream, or queues it if something is
+// already playing. Playlist files are imported as transient playlists,
+// and played from the top.
 func (*Player) OpenUri(s string) *dbus.Error {
+	switch {
+	case strings.HasPrefix(s, base.SupportedURISchemeFile+"://") &&
+		base.IsSupportedPlaylistURL(s):
+		bar, err := models.GetActivePerspectiveIndex().GetPlaybar()
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+
+		pl, msgs, err := bar.ImportPlaylist(s, true)
+		if err != nil {
+			return dbus.MakeFailedError(
+				fmt.Errorf("Error importing playlist at `%v`: %w", s, err),
+			)
+		}
+		if len(msgs) > 0 {
+			slog.Warn("Playlist imported with errors", "location", s, "errors", msgs)
+		}
+
+		go GetEventsInstance().TryPlayingFromBar(pl, 1)
+	case isPlayableURI(s):
+		if !GetEventsInstance().IsStreaming() {
+			GetEventsInstance().PlayStreams(true, []string{s}, nil)
+			break
+		}
+
+		q, err := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		q.Add([]string{s}, nil)
+	default:
+		return dbus.MakeFailedError(fmt.Errorf("Unsupported URI: %v", s))
+	}
 	return nil
 }
 
@@ -112,61 +184,106 @@ func (*Player) PlaybackStatus() string {
 }
 
 func (*Player) LoopStatus(s string) (string, *dbus.Error) {
-	// TODO: implement
-	return "None", nil
+	rm, err := models.RepeatModeFromString(s)
+	if err != nil {
+		return "", dbus.MakeFailedError(err)
+	}
+
+	err = GetEventsInstance().SetRepeatMode(models.GetActivePerspectiveIndex(), rm)
+	if err != nil {
+		return "", dbus.MakeFailedError(err)
+	}
+	return GetEventsInstance().GetRepeatMode().String(), nil
+}
+
+func (p *Player) onLoopStatusChange(c *prop.Change) *dbus.Error {
+	in, ok := c.Value.(string)
+	if !ok {
+		return prop.ErrInvalidArg
+	}
+	_, err := p.LoopStatus(in)
+	return err
 }
 
 func (*Player) Rate(in float64) (float64, *dbus.Error) {
-	// TODO: implement
-	return float64(1.), nil
+	if in <= 0 {
+		return 0, dbus.MakeFailedError(fmt.Errorf("Invalid rate: %v", in))
+	}
+	GetEventsInstance().SetRate(in)
+	return GetEventsInstance().GetRate(), nil
+}
+
+func (p *Player) onRateChange(c *prop.Change) *dbus.Error {
+	in, ok := c.Value.(float64)
+	if !ok {
+		return prop.ErrInvalidArg
+	}
+	_, err := p.Rate(in)
+	return err
 }
 
 func (*Player) Shuffle(b bool) (bool, *dbus.Error) {
-	// TODO: implement
-	return false, nil
+	err := GetEventsInstance().SetShuffle(models.GetActivePerspectiveIndex(), b)
+	if err != nil {
+		return false, dbus.MakeFailedError(err)
+	}
+	return GetEventsInstance().GetShuffle(), nil
+}
+
+func (p *Player) onShuffleChange(c *prop.Change) *dbus.Error {
+	in, ok := c.Value.(bool)
+	if !ok {
+		return prop.ErrInvalidArg
+	}
+	_, err := p.Shuffle(in)
+	return err
 }
 
 func (*Player) Metadata() map[string]dbus.Variant {
 	pb, t := GetEventsInstance().GetPlayback()
-	if t != nil {
+	if pb == nil && t == nil {
 		return map[string]dbus.Variant{
-			"xesam:album":          dbus.MakeVariant(t.Album),
-			"xesam:title":          dbus.MakeVariant(t.Title),
-			"xesam:url":            dbus.MakeVariant(t.Location),
-			"xesam:contentCreated": dbus.MakeVariant(t.Year),
-			"xesam:albumArtist":    dbus.MakeVariant([]string{t.Albumartist}),
-			"xesam:artist":         dbus.MakeVariant([]string{t.Artist}),
-			"xesam:genre":          dbus.MakeVariant([]string{t.Genre}),
-			"xesam:composer":       dbus.MakeVariant([]string{t.Composer}),
-			"xesam:trackNumber":    dbus.MakeVariant(t.Tracknumber),
-			"xesam:discNumber":     dbus.MakeVariant(t.Discnumber),
-			"mpris:artUrl":         dbus.MakeVariant(t.Cover),
-			"mpris:length":         dbus.MakeVariant(time.Duration(t.Duration) / time.Microsecond),
-			"mpris:trackid":        dbus.MakeVariant(t.ID),
+			"mpris:trackid": dbus.MakeVariant(dbus.ObjectPath(mpris.NoTrack)),
 		}
 	}
-	return map[string]dbus.Variant{
-		"mpris:trackid": dbus.MakeVariant(pb.ID),
-		"xesam:url":     dbus.MakeVariant(pb.Location),
+
+	var location string
+	if pb != nil {
+		location = pb.Location
 	}
+	return trackMetadata(trackObjectPath(pb), t, location)
 }
 
-func (*Player) Volume(in float64) (float64, *dbus.Error) {
-	// TODO: implement
-	return float64(1.), nil
+func (p *Player) Volume(in float64) (float64, *dbus.Error) {
+	GetEventsInstance().SetVolume(in)
+	return p.currentVolume(), nil
 }
 
+func (*Player) currentVolume() float64 {
+	volume, _ := GetEventsInstance().GetVolume()
+	return volume
+}
+
+func (p *Player) onVolumeChange(c *prop.Change) *dbus.Error {
+	in, ok := c.Value.(float64)
+	if !ok {
+		return prop.ErrInvalidArg
+	}
+	_, err := p.Volume(in)
+	return err
+}
+
+// Position returns the current position, in microseconds.
 func (*Player) Position() int64 {
-	pb, _ := GetEventsInstance().GetPlayback()
-	return pb.Skip
+	return instance.eng.getPosition() / int64(time.Microsecond)
 }
 
 func (*Player) MinimumRate() float64 {
-	return float64(1.)
+	return MinimumRate
 }
 
 func (*Player) MaximumRate() float64 {
-	return float64(1.)
+	return MaximumRate
 }
 
 func (*Player) CanGoNext() bool {
@@ -186,9 +303,66 @@ func (*Player) CanPause() bool {
 }
 
 func (*Player) CanSeek() bool {
-	return false
+	return instance.eng.canSeek.Load()
 }
 
 func (*Player) CanControl() bool {
 	return true
 }
+
+// trackMetadata returns the MPRIS metadata for the given track ID, from
+// the track if there is one, or just the location otherwise.
+func trackMetadata(id dbus.ObjectPath, t *models.Track, location string) map[string]dbus.Variant {
+	if t == nil {
+		return map[string]dbus.Variant{
+			"mpris:trackid": dbus.MakeVariant(id),
+			"xesam:url":     dbus.MakeVariant(location),
+		}
+	}
+	return map[string]dbus.Variant{
+		"xesam:album":          dbus.MakeVariant(t.Album),
+		"xesam:title":          dbus.MakeVariant(t.Title),
+		"xesam:url":            dbus.MakeVariant(t.Location),
+		"xesam:contentCreated": dbus.MakeVariant(t.Year),
+		"xesam:albumArtist":    dbus.MakeVariant([]string{t.Albumartist}),
+		"xesam:artist":         dbus.MakeVariant([]string{t.Artist}),
+		"xesam:genre":          dbus.MakeVariant([]string{t.Genre}),
+		"xesam:composer":       dbus.MakeVariant([]string{t.Composer}),
+		"xesam:trackNumber":    dbus.MakeVariant(t.Tracknumber),
+		"xesam:discNumber":     dbus.MakeVariant(t.Discnumber),
+		"mpris:artUrl":         dbus.MakeVariant(coverURL(t.Cover)),
+		"mpris:length":         dbus.MakeVariant(time.Duration(t.Duration) / time.Microsecond),
+		"mpris:trackid":        dbus.MakeVariant(id),
+	}
+}
+
+// isPlayableURI returns true if the given URI is a stream, or a supported
+// file.
+func isPlayableURI(s string) bool {
+	if isRemoteLocation(s) {
+		return true
+	}
+	return strings.HasPrefix(s, base.SupportedURISchemeFile+"://") &&
+		base.IsSupportedURL(s)
+}
+
+// coverURL returns the URL of the given cover, saved in the covers
+// directory, or an empty string if there is none.
+func coverURL(cover string) string {
+	if cover == "" {
+		return ""
+	}
+	u, err := urlstr.PathToURL(filepath.Join(base.CoversDir(), cover))
+	if err != nil {
+		return ""
+	}
+	return u
+}
+
+// trackObjectPath returns the MPRIS track ID for the given playback.
+func trackObjectPath(pb *models.Playback) dbus.ObjectPath {
+	if pb == nil {
+		return dbus.ObjectPath(mpris.NoTrack)
+	}
+	return dbus.ObjectPath(fmt.Sprintf("%s/%d", mpris.TrackPathPrefix, pb.ID))
+}
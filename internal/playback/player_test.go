@@ -0,0 +1,94 @@
+package playback
+
+import (
+	"testing"
+	"time"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenUri(t *testing.T) {
+	startFakeEngine(t, "playback/playlist-flow")
+	require.Eventually(t, func() bool {
+		return len(models.PlaybackChanged) == 0
+	}, waitTimeout, waitTick)
+
+	p := &Player{}
+
+	require.Nil(t, p.OpenUri("http://fake.test/track01.ogg"))
+	models.TriggerPlaybackChange() // GORM hooks are off in test mode
+	require.Eventually(t, func() bool {
+		pb := instance.eng.pb.Load()
+		return pb != nil && pb.Location == "http://fake.test/track01.ogg" &&
+			instance.IsPlaying()
+	}, waitTimeout, waitTick)
+
+	require.Nil(t, p.OpenUri("http://fake.test/track02.ogg"), "queued while playing")
+	assert.Eventually(t, func() bool {
+		qts, _ := models.GetAllQueueTracks(models.GetActivePerspectiveIndex(), 0)
+		return len(qts) == 1 && qts[0].Location == "http://fake.test/track02.ogg"
+	}, waitTimeout, waitTick)
+
+	assert.NotNil(t, p.OpenUri("ftp://fake.test/track03.ogg"))
+	assert.NotNil(t, p.OpenUri("file:///tmp/notes.txt"))
+}
+
+func TestSeek(t *testing.T) {
+	startFakeEngine(t, "playback/playlist-flow")
+
+	playFromBar(t, 1, 1)
+	waitForTrack(t, 1)
+
+	p := &Player{}
+	e := instance.eng
+	require.Eventually(t, func() bool {
+		return e.seekable.Load() && p.CanSeek() && e.duration.Load() > 0
+	}, waitTimeout, waitTick, "stream is not seekable")
+
+	// position returns the position of the fake pipeline
+	position := func() time.Duration {
+		pos, ok := e.pipeline.Load().Position()
+		require.True(t, ok)
+		return time.Duration(pos)
+	}
+
+	usec := func(d time.Duration) int64 {
+		return d.Microseconds()
+	}
+
+	curr := trackObjectPath(e.pb.Load())
+
+	t.Run("seek forward", func(t *testing.T) {
+		require.Nil(t, p.Seek(usec(30*time.Second)))
+		assert.Equal(t, 30*time.Second, position())
+	})
+
+	t.Run("negative position clamps to zero", func(t *testing.T) {
+		require.Nil(t, p.Seek(usec(-time.Minute)))
+		assert.Equal(t, time.Duration(0), position())
+	})
+
+	t.Run("set position", func(t *testing.T) {
+		require.Nil(t, p.SetPosition(curr, usec(90*time.Second)))
+		assert.Equal(t, 90*time.Second, position())
+	})
+
+	t.Run("track mismatch does nothing", func(t *testing.T) {
+		other := dbus.ObjectPath(string(curr) + "0")
+		require.Nil(t, p.SetPosition(other, usec(10*time.Second)))
+		assert.Equal(t, 90*time.Second, position())
+	})
+
+	t.Run("set position past the end does nothing", func(t *testing.T) {
+		require.Nil(t, p.SetPosition(curr, usec(fakeDefaultDuration+time.Second)))
+		assert.Equal(t, 90*time.Second, position())
+	})
+
+	t.Run("seek past the end goes to next", func(t *testing.T) {
+		require.Nil(t, p.Seek(usec(fakeDefaultDuration)))
+		waitForTrack(t, 2)
+	})
+}
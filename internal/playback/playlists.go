@@ -0,0 +1,189 @@
+package playback
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/mpris"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+)
+
+// Playlists implements the mpris.Playlists interface, i.e., the playlists
+// in the playbar of the active perspective.
+type Playlists struct {
+	*mpris.Instance
+
+	mu     sync.Mutex
+	count  uint32              // as last reported
+	active mpris.MaybePlaylist // as last reported
+}
+
+func newPlaylists(ins *mpris.Instance) *Playlists {
+	return &Playlists{Instance: ins}
+}
+
+func (*Playlists) IntrospectInterface() introspect.Interface {
+	return mpris.PlaylistsIntrospectInterface()
+}
+
+func (p *Playlists) Properties() map[string]*prop.Prop {
+	count, active := p.PlaylistCount(), p.ActivePlaylist()
+
+	p.mu.Lock()
+	p.count, p.active = count, active
+	p.mu.Unlock()
+
+	return map[string]*prop.Prop{
+		"PlaylistCount":  {Value: count, Emit: prop.EmitTrue},
+		"Orderings":      {Value: p.Orderings(), Emit: prop.EmitTrue},
+		"ActivePlaylist": {Value: active, Emit: prop.EmitTrue},
+	}
+}
+
+// ActivatePlaylist starts playing the given playlist from the top.
+func (*Playlists) ActivatePlaylist(o dbus.ObjectPath) *dbus.Error {
+	id, err := parsePlaylistObjectPath(o)
+	if err != nil {
+		return dbus.MakeFailedError(err)
+	}
+
+	pl := &models.Playlist{}
+	if err := pl.Read(id); err != nil {
+		return dbus.MakeFailedError(
+			fmt.Errorf("Playlist with ID=%v does not exist: %w", id, err),
+		)
+	}
+
+	go GetEventsInstance().TryPlayingFromBar(pl, 1)
+	return nil
+}
+
+func (p *Playlists) GetPlaylists(index, maxCount uint32, order string,
+	reverseOrder bool) ([]mpris.Playlist, *dbus.Error) {
+
+	var cmpFunc func(a, b *models.Playlist) int
+	switch order {
+	case mpris.OrderingAlphabetical:
+		cmpFunc = func(a, b *models.Playlist) int {
+			return cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		}
+	case mpris.OrderingCreationDate:
+		cmpFunc = func(a, b *models.Playlist) int {
+			return cmp.Compare(a.CreatedAt, b.CreatedAt)
+		}
+	case mpris.OrderingModifiedDate:
+		cmpFunc = func(a, b *models.Playlist) int {
+			return cmp.Compare(a.UpdatedAt, b.UpdatedAt)
+		}
+	default:
+		return nil, dbus.MakeFailedError(fmt.Errorf("Unsupported ordering: %v", order))
+	}
+
+	pls := playbarEntries()
+	slices.SortStableFunc(pls, cmpFunc)
+	if reverseOrder {
+		slices.Reverse(pls)
+	}
+
+	out := []mpris.Playlist{}
+	for i := int(index); i < len(pls) && len(out) < int(maxCount); i++ {
+		out = append(out, playlistToMPRIS(pls[i]))
+	}
+	return out, nil
+}
+
+func (*Playlists) PlaylistCount() uint32 {
+	return uint32(len(playbarEntries()))
+}
+
+func (*Playlists) Orderings() []string {
+	return []string{
+		mpris.OrderingAlphabetical,
+		mpris.OrderingCreationDate,
+		mpris.OrderingModifiedDate,
+	}
+}
+
+func (*Playlists) ActivePlaylist() mpris.MaybePlaylist {
+	pl := models.GetActiveEntry()
+	if pl.ID == 0 {
+		return mpris.MaybePlaylist{Playlist: mpris.Playlist{ID: "/"}}
+	}
+	return mpris.MaybePlaylist{Valid: true, Playlist: playlistToMPRIS(pl)}
+}
+
+// refresh updates the playlist count and the active playlist, if they
+// changed, and emits PlaylistChanged for the playlist in the given
+// playbar event, if any.
+func (p *Playlists) refresh(e subscription.Event) {
+	count, active := p.PlaylistCount(), p.ActivePlaylist()
+
+	p.mu.Lock()
+	prevCount, prevActive := p.count, p.active
+	p.count, p.active = count, active
+	p.mu.Unlock()
+
+	if count != prevCount {
+		p.SetPlaylistsProperty("PlaylistCount", count)
+	}
+	if active != prevActive {
+		p.SetPlaylistsProperty("ActivePlaylist", active)
+	}
+
+	if e.Idx != int(models.PlaybarEventItemChanged) {
+		return
+	}
+	pl, ok := e.Data.(*models.Playlist)
+	if !ok {
+		return
+	}
+	bar, err := models.GetActivePerspectiveIndex().GetPlaybar()
+	if err != nil || bar.ID != pl.PlaybarID {
+		return
+	}
+	p.EmitPlaylistChanged(playlistToMPRIS(pl))
+}
+
+// playbarEntries returns the playlists in the playbar of the active
+// perspective.
+func playbarEntries() []*models.Playlist {
+	bar, err := models.GetActivePerspectiveIndex().GetPlaybar()
+	if err != nil {
+		return []*models.Playlist{}
+	}
+	return bar.GetAllEntries(0)
+}
+
+func playlistToMPRIS(pl *models.Playlist) mpris.Playlist {
+	return mpris.Playlist{
+		ID:   playlistObjectPath(pl.ID),
+		Name: pl.Name,
+		Icon: coverURL(pl.GetCover()),
+	}
+}
+
+// playlistObjectPath returns the MPRIS playlist ID for the given playlist.
+func playlistObjectPath(id int64) dbus.ObjectPath {
+	return dbus.ObjectPath(fmt.Sprintf("%s/%d", mpris.PlaylistPathPrefix, id))
+}
+
+func parsePlaylistObjectPath(o dbus.ObjectPath) (id int64, err error) {
+	s, ok := strings.CutPrefix(string(o), mpris.PlaylistPathPrefix+"/")
+	if ok {
+		id, err = strconv.ParseInt(s, 10, 64)
+		if err == nil && id > 0 {
+			return
+		}
+	}
+
+	err = fmt.Errorf("Invalid playlist ID: %v", o)
+	return
+}
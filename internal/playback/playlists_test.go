@@ -0,0 +1,40 @@
+package playback
+
+import (
+	"testing"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/mpris"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaylists(t *testing.T) {
+	tests.SetupTest(t, fixturesDir("playback/tracklist"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	p := newPlaylists(&mpris.Instance{})
+	p.Properties()
+
+	assert.Equal(t, uint32(2), p.count)
+	assert.True(t, p.active.Valid)
+	assert.Equal(t, playlistObjectPath(1), p.active.Playlist.ID)
+	assert.Equal(t, "some playlist", p.active.Playlist.Name)
+
+	pls, derr := p.GetPlaylists(0, 10, mpris.OrderingAlphabetical, false)
+	require.Nil(t, derr)
+	require.Len(t, pls, 2)
+	assert.Equal(t, "another playlist", pls[0].Name)
+	assert.Equal(t, "some playlist", pls[1].Name)
+
+	pls, derr = p.GetPlaylists(1, 10, mpris.OrderingAlphabetical, true)
+	require.Nil(t, derr)
+	require.Len(t, pls, 1)
+	assert.Equal(t, "another playlist", pls[0].Name)
+
+	_, derr = p.GetPlaylists(0, 10, mpris.OrderingLastPlayDate, false)
+	assert.NotNil(t, derr)
+
+	assert.NotNil(t, p.ActivatePlaylist("/not/a/playlist"))
+	assert.NotNil(t, p.ActivatePlaylist(playlistObjectPath(99)))
+}
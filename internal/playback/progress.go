@@ -0,0 +1,41 @@
+package playback
+
+import (
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+)
+
+// minimumProgressInterval defines the shortest interval allowed between
+// progress updates.
+const minimumProgressInterval = 100 * time.Millisecond
+
+// Progress defines the progress of the current stream.
+type Progress struct {
+	Position  int64 // in nanoseconds
+	Duration  int64 // in nanoseconds, zero if unknown
+	Buffering int   // percent, 100 when not buffering
+	Seekable  bool
+	Live      bool
+}
+
+// progressInterval returns the configured interval between progress
+// updates.
+func progressInterval() time.Duration {
+	d := time.Duration(base.Conf.Server.Playback.Progress.Interval) * time.Millisecond
+	return max(d, minimumProgressInterval)
+}
+
+func (e *engine) getProgress() Progress {
+	if e.pb.Load() == nil {
+		return Progress{}
+	}
+
+	return Progress{
+		Position:  e.getPosition(),
+		Duration:  e.duration.Load(),
+		Buffering: int(e.buffering.Load()),
+		Seekable:  e.canSeek.Load(),
+		Live:      e.live.Load(),
+	}
+}
@@ -0,0 +1,58 @@
+package playback
+
+import (
+	"log/slog"
+	"math"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+)
+
+// applyReplayGain sets the pipeline's gain for the given playback.
+func (e *engine) applyReplayGain(pb *models.Playback) {
+	pl := e.pipeline.Load()
+	if pl == nil {
+		return
+	}
+
+	factor := replayGainFactor(pb)
+	if err := pl.SetGain(factor); err != nil {
+		slog.Warn("Failed to apply ReplayGain", "error", err)
+		return
+	}
+	slog.Debug("ReplayGain applied", "factor", factor)
+}
+
+// replayGainFactor returns the linear volume factor to apply to the given
+// playback, according to the configured ReplayGain mode.
+func replayGainFactor(pb *models.Playback) float64 {
+	rg := base.Conf.Server.Playback.ReplayGain
+	if rg.Mode == config.ReplayGainOff || pb == nil || pb.TrackID == 0 {
+		return 1
+	}
+
+	t := &models.Track{}
+	if err := t.Read(pb.TrackID); err != nil {
+		slog.With(
+			"track_id", pb.TrackID,
+			"error", err,
+		).Error("Failed to read track")
+		return 1
+	}
+
+	gain, peak := t.Trackgain, t.Trackpeak
+	if rg.Mode == config.ReplayGainAlbum && (t.Albumgain != 0 || t.Albumpeak != 0) ||
+		rg.Mode == config.ReplayGainTrack && gain == 0 && peak == 0 {
+		gain, peak = t.Albumgain, t.Albumpeak
+	}
+	if gain == 0 && peak == 0 {
+		return 1
+	}
+
+	factor := math.Pow(10, (gain+rg.Preamp)/20)
+	if !rg.AllowClipping && peak > 0 && factor*peak > 1 {
+		factor = 1 / peak
+	}
+	return factor
+}
@@ -0,0 +1,58 @@
+package playback
+
+import (
+	"math"
+	"testing"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplayGainFactor(t *testing.T) {
+	tests.SetupTest(t, fixturesDir("playback/replaygain"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	rg := base.Conf.Server.Playback.ReplayGain
+	t.Cleanup(func() { base.Conf.Server.Playback.ReplayGain = rg })
+
+	testCases := []struct {
+		name          string
+		mode          string
+		preamp        float64
+		allowClipping bool
+		trackID       int64
+		want          float64
+	}{
+		{"off", config.ReplayGainOff, 0, false, 1, 1},
+		{"no track", config.ReplayGainTrack, 0, false, 0, 1},
+		{"unknown track", config.ReplayGainTrack, 0, false, 99, 1},
+		{"track mode", config.ReplayGainTrack, 0, false, 1, math.Pow(10, -6.0/20)},
+		{"album mode", config.ReplayGainAlbum, 0, false, 1, math.Pow(10, -3.0/20)},
+		{"track mode without track tags", config.ReplayGainTrack, 0, false, 3, math.Pow(10, -3.0/20)},
+		{"album mode without album tags", config.ReplayGainAlbum, 0, false, 2, math.Pow(10, -6.0/20)},
+		{"untagged", config.ReplayGainTrack, 0, false, 4, 1},
+		{"preamp", config.ReplayGainTrack, 3, false, 1, math.Pow(10, -3.0/20)},
+		{"peak is limited", config.ReplayGainTrack, 0, false, 5, 1 / 0.9},
+		{"clipping allowed", config.ReplayGainTrack, 0, true, 5, math.Pow(10, 6.0/20)},
+		{"preamp is limited by peak", config.ReplayGainTrack, 15, false, 1, 1 / 0.5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			base.Conf.Server.Playback.ReplayGain.Mode = tc.mode
+			base.Conf.Server.Playback.ReplayGain.Preamp = tc.preamp
+			base.Conf.Server.Playback.ReplayGain.AllowClipping = tc.allowClipping
+
+			got := replayGainFactor(&models.Playback{TrackID: tc.trackID})
+			assert.InDelta(t, tc.want, got, 1e-9)
+		})
+	}
+
+	t.Run("no playback", func(t *testing.T) {
+		base.Conf.Server.Playback.ReplayGain.Mode = config.ReplayGainTrack
+		assert.Equal(t, 1.0, replayGainFactor(nil))
+	})
+}
@@ -0,0 +1,12 @@
+package playback
+
+import (
+	"github.com/jwmwalrus/m3u-etcetera/internal/scrobble"
+)
+
+// updateNowPlaying reports the current track to the scrobbling service.
+func (e *engine) updateNowPlaying() {
+	if pb, t := instance.GetPlayback(); pb != nil && pb.TrackID > 0 {
+		scrobble.NowPlaying(t)
+	}
+}
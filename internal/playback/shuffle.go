@@ -0,0 +1,125 @@
+package playback
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"slices"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+)
+
+// shuffleOrder defines the play order for a shuffled playlist.
+type shuffleOrder struct {
+	plID int64
+	ids  []int64 // playlist-track IDs
+}
+
+// getShuffle returns true if shuffle is enabled for the active perspective.
+func (e *engine) getShuffle() bool {
+	bar, err := models.GetActivePerspectiveIndex().GetPlaybar()
+	if err != nil {
+		slog.Error("Failed to get playbar for active perspective", "error", err)
+		return false
+	}
+	return bar.Shuffle
+}
+
+// getTrackAfter returns the playlist track after the given one, honoring
+// the shuffle and repeat modes.
+func (e *engine) getTrackAfter(pt *models.PlaylistTrack,
+	goingBack bool) (newpt *models.PlaylistTrack, err error) {
+
+	if e.getShuffle() {
+		return e.getShuffledTrackAfter(pt, goingBack)
+	}
+
+	newpt, err = pt.GetTrackAfter(goingBack)
+	if err != nil && !goingBack && e.getRepeatMode() == models.RepeatPlaylist {
+		slog.Info("Repeating playlist")
+		pl := pt.Playlist
+		newpt, err = pl.GetFirstTrack()
+	}
+	return
+}
+
+// getShuffledTrackAfter returns the playlist track after the given one,
+// according to the shuffled order.
+func (e *engine) getShuffledTrackAfter(pt *models.PlaylistTrack,
+	goingBack bool) (*models.PlaylistTrack, error) {
+
+	order := e.syncShuffleOrder(pt)
+
+	idx := slices.Index(order.ids, pt.ID)
+	if goingBack {
+		idx--
+	} else {
+		idx++
+	}
+
+	if idx >= len(order.ids) && e.getRepeatMode() == models.RepeatPlaylist {
+		slog.Info("Repeating shuffled playlist")
+		idx = 0
+	}
+
+	if idx < 0 || idx >= len(order.ids) {
+		return nil, fmt.Errorf("There is no track after")
+	}
+
+	newpt := &models.PlaylistTrack{}
+	err := newpt.Read(order.ids[idx])
+	return newpt, err
+}
+
+// resetShuffleOrder discards the current shuffled order and, if shuffle
+// is enabled and there is an active playlist, generates a new one.
+func (e *engine) resetShuffleOrder() {
+	e.shuffle.Store(nil)
+
+	pt := e.pt.Load()
+	if pt == nil || !e.getShuffle() {
+		return
+	}
+	e.syncShuffleOrder(pt)
+}
+
+// syncShuffleOrder returns the shuffled order for the playlist of the given
+// playlist track, creating it if needed. The existing order is kept stable:
+// tracks removed from the playlist are dropped, and tracks added to it are
+// inserted at random after the given one.
+func (e *engine) syncShuffleOrder(pt *models.PlaylistTrack) *shuffleOrder {
+	pl := pt.Playlist
+
+	order := e.shuffle.Load()
+	if order == nil || order.plID != pl.ID || !slices.Contains(order.ids, pt.ID) {
+		order = &shuffleOrder{plID: pl.ID, ids: pl.GetShuffledTrackIDs(pt.ID)}
+		e.shuffle.Store(order)
+		return order
+	}
+
+	ids := pl.GetPlaylistTrackIDs()
+
+	kept := make([]int64, 0, len(ids))
+	for _, id := range order.ids {
+		if slices.Contains(ids, id) {
+			kept = append(kept, id)
+		}
+	}
+
+	if len(kept) == len(order.ids) && len(kept) == len(ids) {
+		return order
+	}
+
+	for _, id := range ids {
+		if slices.Contains(kept, id) {
+			continue
+		}
+		after := slices.Index(kept, pt.ID) + 1
+		at := after + rand.Intn(len(kept)-after+1)
+		kept = slices.Insert(kept, at, id)
+	}
+
+	order = &shuffleOrder{plID: pl.ID, ids: kept}
+	e.shuffle.Store(order)
+	return order
+}
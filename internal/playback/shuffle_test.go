@@ -0,0 +1,102 @@
+package playback
+
+import (
+	"testing"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncShuffleOrder(t *testing.T) {
+	tests.SetupTest(t, fixturesDir("playback/shuffle"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	pt := &models.PlaylistTrack{}
+	require.NoError(t, pt.Read(1))
+
+	t.Run("new order starts with the given track", func(t *testing.T) {
+		e := &engine{}
+		order := e.syncShuffleOrder(pt)
+		require.NotEmpty(t, order.ids)
+		assert.Equal(t, int64(1), order.ids[0])
+		assert.ElementsMatch(t, []int64{1, 2, 3}, order.ids)
+		assert.Same(t, order, e.syncShuffleOrder(pt), "order is kept")
+	})
+
+	t.Run("order of another playlist is replaced", func(t *testing.T) {
+		e := &engine{}
+		e.shuffle.Store(&shuffleOrder{plID: 2, ids: []int64{4}})
+		order := e.syncShuffleOrder(pt)
+		assert.Equal(t, int64(1), order.plID)
+		assert.ElementsMatch(t, []int64{1, 2, 3}, order.ids)
+	})
+
+	t.Run("removed tracks are dropped", func(t *testing.T) {
+		e := &engine{}
+		e.shuffle.Store(&shuffleOrder{plID: 1, ids: []int64{3, 99, 1, 2}})
+		assert.Equal(t, []int64{3, 1, 2}, e.syncShuffleOrder(pt).ids)
+	})
+
+	t.Run("added tracks go after the given one", func(t *testing.T) {
+		e := &engine{}
+		e.shuffle.Store(&shuffleOrder{plID: 1, ids: []int64{2, 1}})
+		assert.Equal(t, []int64{2, 1, 3}, e.syncShuffleOrder(pt).ids)
+	})
+}
+
+func TestGetShuffledTrackAfter(t *testing.T) {
+	tests.SetupTest(t, fixturesDir("playback/shuffle"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	bar, err := models.GetActivePerspectiveIndex().GetPlaybar()
+	require.NoError(t, err)
+
+	readPt := func(t *testing.T, id int64) *models.PlaylistTrack {
+		pt := &models.PlaylistTrack{}
+		require.NoError(t, pt.Read(id))
+		return pt
+	}
+
+	e := &engine{}
+	e.shuffle.Store(&shuffleOrder{plID: 1, ids: []int64{3, 1, 2}})
+
+	testCases := []struct {
+		name      string
+		from      int64
+		goingBack bool
+		repeat    models.RepeatMode
+		want      int64 // zero if there is no track
+	}{
+		{"next", 3, false, models.RepeatNone, 1},
+		{"previous", 2, true, models.RepeatNone, 1},
+		{"no previous at the start", 3, true, models.RepeatPlaylist, 0},
+		{"no next at the end", 2, false, models.RepeatNone, 0},
+		{"no next at the end when repeating the track", 2, false, models.RepeatTrack, 0},
+		{"wraps around when repeating the playlist", 2, false, models.RepeatPlaylist, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, bar.SetRepeatMode(tc.repeat))
+
+			got, err := e.getShuffledTrackAfter(readPt(t, tc.from), tc.goingBack)
+			if tc.want == 0 {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got.ID)
+		})
+	}
+
+	t.Run("one-track playlist repeats its track", func(t *testing.T) {
+		require.NoError(t, bar.SetRepeatMode(models.RepeatPlaylist))
+
+		e := &engine{}
+		got, err := e.getShuffledTrackAfter(readPt(t, 4), false)
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), got.ID)
+	})
+}
@@ -0,0 +1,257 @@
+package playback
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/jwmwalrus/bnp/onerror"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+)
+
+// SleepMode defines when the sleep timer goes off.
+type SleepMode int
+
+// Defined sleep modes.
+const (
+	// SleepOff means there is no sleep timer.
+	SleepOff SleepMode = iota
+
+	// SleepAfterMinutes goes off after the given number of minutes.
+	SleepAfterMinutes
+
+	// SleepEndOfTrack goes off at the end of the current track (or chapter).
+	SleepEndOfTrack
+
+	// SleepEndOfPlaylist goes off at the end of the active playlist.
+	SleepEndOfPlaylist
+
+	// SleepEndOfQueue goes off when there is nothing left in the queue.
+	SleepEndOfQueue
+)
+
+// sleepFadeDuration defines the length of the sleep fade-out.
+const sleepFadeDuration = 10 * time.Second
+
+// SleepTimer defines the sleep timer settings.
+type SleepTimer struct {
+	Mode    SleepMode
+	Minutes int
+	FadeOut bool
+	Pause   bool // pause instead of stopping
+}
+
+type sleepTimer struct {
+	SleepTimer
+	deadline time.Time
+	timer    *time.Timer
+	fading   atomic.Bool
+}
+
+// getSleepTimer returns the current sleep timer, if any, and the time left
+// for it to go off, when known.
+func (e *engine) getSleepTimer() (st SleepTimer, remaining time.Duration) {
+	s := e.sleep.Load()
+	if s == nil {
+		return
+	}
+
+	st = s.SleepTimer
+	switch st.Mode {
+	case SleepAfterMinutes:
+		remaining = max(time.Until(s.deadline), 0)
+	case SleepEndOfTrack:
+		if d := e.duration.Load(); d > 0 {
+			left := float64(d-e.lastPosition.Load()) / e.getRate()
+			remaining = max(time.Duration(left), 0)
+		}
+	default:
+	}
+	return
+}
+
+// setSleepTimer replaces the current sleep timer.
+func (e *engine) setSleepTimer(st SleepTimer) error {
+	s := &sleepTimer{SleepTimer: st}
+
+	switch st.Mode {
+	case SleepOff:
+		s = nil
+	case SleepAfterMinutes:
+		if st.Minutes <= 0 {
+			return fmt.Errorf("Invalid number of minutes: %v", st.Minutes)
+		}
+		d := time.Duration(st.Minutes) * time.Minute
+		s.deadline = time.Now().Add(d)
+		if st.FadeOut {
+			d = max(d-sleepFadeDuration, 0)
+		}
+		s.timer = time.AfterFunc(d, func() { e.goToSleep(s) })
+	case SleepEndOfTrack, SleepEndOfPlaylist, SleepEndOfQueue:
+	default:
+		return fmt.Errorf("Invalid sleep mode: %v", st.Mode)
+	}
+
+	slog.Info("Setting sleep timer", "timer", st)
+
+	if old := e.sleep.Swap(s); old != nil {
+		if old.timer != nil {
+			old.timer.Stop()
+		}
+		if old.fading.Load() {
+			e.restoreVolume()
+		}
+	}
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	return nil
+}
+
+// goToSleep is called when a SleepAfterMinutes timer goes off.
+func (e *engine) goToSleep(s *sleepTimer) {
+	if e.sleep.Load() != s {
+		return
+	}
+
+	if pl := e.pipeline.Load(); s.FadeOut &&
+		pl != nil &&
+		e.state.Load() == StatePlaying {
+
+		s.fading.Store(true)
+		e.fadeVolume(pl, 1, 0, max(time.Until(s.deadline), 0), nil)
+	}
+
+	if !e.sleep.CompareAndSwap(s, nil) {
+		// the timer was replaced while fading out
+		return
+	}
+
+	slog.Info("Sleep timer went off", "timer", s.SleepTimer)
+
+	if s.Pause {
+		onerror.Log(GetEventsInstance().PauseStream(false))
+		e.restoreVolume()
+	} else {
+		GetEventsInstance().StopAll()
+	}
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+}
+
+// fadeOutBeforeSleep starts fading out the current stream, if the sleep
+// timer goes off at the end of it.
+func (e *engine) fadeOutBeforeSleep(position int64) {
+	s := e.sleep.Load()
+	if s == nil ||
+		!s.FadeOut ||
+		s.Mode == SleepAfterMinutes ||
+		s.fading.Load() {
+		return
+	}
+
+	duration := e.duration.Load()
+	left := time.Duration(float64(duration-position) / e.getRate())
+	if duration == 0 || left > sleepFadeDuration || !e.sleepsAtEndOfStream() {
+		return
+	}
+
+	pl := e.pipeline.Load()
+	if pl == nil {
+		return
+	}
+
+	s.fading.Store(true)
+	go e.fadeVolume(pl, 1, 0, max(left, 0), nil)
+}
+
+// sleepAtEndOfStream applies the sleep timer, at the end of the current
+// stream. It returns false if the sleep timer does not go off now.
+func (e *engine) sleepAtEndOfStream() bool {
+	if !e.sleepsAtEndOfStream() {
+		return false
+	}
+
+	s := e.sleep.Swap(nil)
+	if s == nil {
+		return false
+	}
+
+	slog.Info("Sleep timer went off", "timer", s.SleepTimer)
+
+	if s.Pause {
+		e.startPaused.Store(true)
+	} else {
+		e.lastEvent.Store(stopAllEvent)
+		e.updateMPRIS(true)
+	}
+	return true
+}
+
+// sleepsAtEndOfStream returns true if the sleep timer goes off at the end
+// of the current stream.
+func (e *engine) sleepsAtEndOfStream() bool {
+	s := e.sleep.Load()
+	if s == nil {
+		return false
+	}
+
+	switch s.Mode {
+	case SleepEndOfTrack:
+		return true
+	case SleepEndOfPlaylist:
+		return e.isLastInPlaylist()
+	case SleepEndOfQueue:
+		return e.isLastInQueue()
+	default:
+	}
+	return false
+}
+
+// isLastInPlaylist returns true if the current stream is the last one in
+// the active playlist, regardless of the repeat mode.
+func (e *engine) isLastInPlaylist() bool {
+	pt := e.pt.Load()
+	if pt == nil {
+		return true
+	}
+
+	if pb := e.pb.Load(); pb == nil || pb.TrackID != pt.TrackID {
+		return false
+	}
+
+	if e.getShuffle() {
+		order := e.syncShuffleOrder(pt)
+		return slices.Index(order.ids, pt.ID) == len(order.ids)-1
+	}
+
+	_, err := pt.GetTrackAfter(false)
+	return err != nil
+}
+
+// isLastInQueue returns true if there is nothing pending in playback or
+// queue after the current stream.
+func (e *engine) isLastInQueue() bool {
+	var excluded []int64
+	if pb := e.pb.Load(); pb != nil {
+		excluded = append(excluded, pb.ID)
+	}
+	if err := (&models.Playback{}).GetNextToPlay(excluded...); err == nil {
+		return false
+	}
+
+	q, err := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
+	if err != nil {
+		return true
+	}
+	return q.IsEmpty()
+}
+
+// restoreVolume sets the current volume to the running stream.
+func (e *engine) restoreVolume() {
+	if pl := e.pipeline.Load(); pl != nil {
+		onerror.Log(pl.SetVolume(e.getVolume()))
+	}
+}
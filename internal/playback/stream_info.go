@@ -0,0 +1,129 @@
+package playback
+
+import (
+	"log/slog"
+	"strings"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+)
+
+// StreamInfo defines the metadata reported by a remote stream (e.g., the
+// ICY StreamTitle of an internet radio station) while it plays.
+type StreamInfo struct {
+	Title        string
+	Artist       string
+	Organization string // usually, the station's name
+	Bitrate      int    // in bps
+}
+
+// IsEmpty returns true if the stream has not reported anything yet.
+func (si StreamInfo) IsEmpty() bool {
+	return si == StreamInfo{}
+}
+
+// merge returns a copy of the stream info updated with the given tags.
+// ICY streams report the artist and title in a single string, so the title
+// gets split if the artist is not reported separately.
+func (si StreamInfo) merge(tags TagList) StreamInfo {
+	if title, ok := tags.GetString(TagTitle); ok {
+		title = strings.TrimSpace(title)
+		artist, hasArtist := tags.GetString(TagArtist)
+		if !hasArtist {
+			if a, t, found := strings.Cut(title, " - "); found {
+				artist, title = a, t
+			}
+		}
+		si.Title = strings.TrimSpace(title)
+		si.Artist = strings.TrimSpace(artist)
+	} else if artist, ok := tags.GetString(TagArtist); ok {
+		si.Artist = strings.TrimSpace(artist)
+	}
+
+	if org, ok := tags.GetString(TagOrganization); ok {
+		si.Organization = strings.TrimSpace(org)
+	}
+
+	// the actual bitrate of VBR streams keeps changing, so it is taken
+	// only if there is no nominal one
+	if bitrate, ok := tags.GetUint32(TagNominalBitrate); ok && bitrate > 0 {
+		si.Bitrate = int(bitrate)
+	} else if bitrate, ok := tags.GetUint32(TagBitrate); ok && bitrate > 0 &&
+		si.Bitrate == 0 {
+		si.Bitrate = int(bitrate)
+	}
+	return si
+}
+
+// applyTo returns a copy of the given track, or a new one for the given
+// location, with the stream info applied. The station's name takes the
+// place of the album.
+func (si StreamInfo) applyTo(t *models.Track, location string) *models.Track {
+	tcopy := models.Track{Location: location}
+	if t != nil {
+		tcopy = *t
+	}
+	if si.Title != "" {
+		tcopy.Title = si.Title
+	}
+	if si.Artist != "" {
+		tcopy.Artist = si.Artist
+	}
+	if si.Organization != "" {
+		tcopy.Album = si.Organization
+	}
+	return &tcopy
+}
+
+// isRemoteLocation returns true if the given location is streamed over
+// HTTP.
+func isRemoteLocation(location string) bool {
+	return strings.HasPrefix(location, base.SupportedURISchemeHTTP+"://") ||
+		strings.HasPrefix(location, base.SupportedURISchemeHTTPS+"://")
+}
+
+// getStreamInfo returns the metadata reported by the current stream, if
+// it is a remote one.
+func (e *engine) getStreamInfo() StreamInfo {
+	if si := e.streamInfo.Load(); si != nil {
+		return *si
+	}
+	return StreamInfo{}
+}
+
+// handleTags updates the stream info from the tags reported by a remote
+// stream, and lets everyone know when it changes.
+func (e *engine) handleTags(tags TagList) {
+	pb := e.pb.Load()
+	if tags == nil || pb == nil || pb.TrackID > 0 ||
+		!isRemoteLocation(pb.Location) {
+		return
+	}
+
+	curr := e.getStreamInfo()
+	si := curr.merge(tags)
+	if si == curr {
+		return
+	}
+	e.streamInfo.Store(&si)
+
+	slog.Debug("Stream info changed", "location", pb.Location, "info", si)
+
+	broadcastToSubscribers(subscription.ToPlaybackEvent)
+	if m := e.mpris; m != nil {
+		go m.SetPlayerProperty("Metadata", m.Metadata())
+	}
+	go e.updateBroadcastTitle()
+
+	if si.Title != curr.Title || si.Artist != curr.Artist {
+		if base.Conf.Server.Playback.Stream.History {
+			go models.AddToStationHistory(
+				pb.Location,
+				si.Title,
+				si.Artist,
+				si.Organization,
+			)
+		}
+	}
+}
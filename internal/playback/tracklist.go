@@ -0,0 +1,324 @@
+package playback
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/godbus/dbus/v5/prop"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/mpris"
+)
+
+// Kinds of entries in the track list.
+const (
+	trackListQueue    = "queue"
+	trackListPlaylist = "playlist"
+)
+
+// TrackList implements the mpris.TrackList interface, i.e., the queue of
+// the active perspective, followed by the active playlist.
+type TrackList struct {
+	*mpris.Instance
+
+	mu     sync.Mutex
+	tracks []dbus.ObjectPath // as last reported
+}
+
+func newTrackList(ins *mpris.Instance) *TrackList {
+	return &TrackList{Instance: ins}
+}
+
+func (*TrackList) IntrospectInterface() introspect.Interface {
+	return mpris.TrackListIntrospectInterface()
+}
+
+func (tl *TrackList) Properties() map[string]*prop.Prop {
+	tracks := tl.Tracks()
+
+	tl.mu.Lock()
+	tl.tracks = tracks
+	tl.mu.Unlock()
+
+	return map[string]*prop.Prop{
+		"Tracks":        {Value: tracks, Emit: prop.EmitInvalidates},
+		"CanEditTracks": {Value: tl.CanEditTracks(), Emit: prop.EmitTrue},
+	}
+}
+
+func (*TrackList) GetTracksMetadata(ids []dbus.ObjectPath) ([]map[string]dbus.Variant, *dbus.Error) {
+	out := []map[string]dbus.Variant{}
+	for _, id := range ids {
+		if m := trackListMetadata(id); m != nil {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// AddTrack adds the given URI after the given entry, i.e., into the queue
+// or the active playlist, depending on where the entry is. If setAsCurrent
+// is true, the URI is played right away instead.
+func (*TrackList) AddTrack(uri string, after dbus.ObjectPath, setAsCurrent bool) *dbus.Error {
+	if !isPlayableURI(uri) {
+		return dbus.MakeFailedError(fmt.Errorf("Unsupported URI: %v", uri))
+	}
+
+	if setAsCurrent {
+		GetEventsInstance().PlayStreams(true, []string{uri}, nil)
+		return nil
+	}
+
+	if after == mpris.NoTrack {
+		q, err := models.GetActivePerspectiveIndex().GetPerspectiveQueue()
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		q.InsertAt(1, []string{uri}, nil)
+		return nil
+	}
+
+	kind, id, err := parseTrackListObjectPath(after)
+	if err != nil {
+		return dbus.MakeFailedError(err)
+	}
+
+	switch kind {
+	case trackListQueue:
+		q, qt, err := readQueueEntry(id)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		q.InsertAt(qt.Position+1, []string{uri}, nil)
+	case trackListPlaylist:
+		pl, pt, err := readPlaylistEntry(id)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		pl.Playbar.InsertIntoPlaylist(pl, pt.Position+1, nil, []string{uri})
+	}
+	return nil
+}
+
+func (*TrackList) RemoveTrack(o dbus.ObjectPath) *dbus.Error {
+	kind, id, err := parseTrackListObjectPath(o)
+	if err != nil {
+		return dbus.MakeFailedError(err)
+	}
+
+	switch kind {
+	case trackListQueue:
+		q, qt, err := readQueueEntry(id)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		q.DeleteAt(qt.Position)
+	case trackListPlaylist:
+		pl, pt, err := readPlaylistEntry(id)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		pl.Playbar.DeleteFromPlaylist(pl, pt.Position)
+	}
+	return nil
+}
+
+// GoTo plays the given entry. A queue entry is taken out of the queue, as
+// if it was its turn.
+func (*TrackList) GoTo(o dbus.ObjectPath) *dbus.Error {
+	kind, id, err := parseTrackListObjectPath(o)
+	if err != nil {
+		return dbus.MakeFailedError(err)
+	}
+
+	switch kind {
+	case trackListQueue:
+		q, qt, err := readQueueEntry(id)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		if qt.TrackID > 0 {
+			GetEventsInstance().PlayStreams(true, nil, []int64{qt.TrackID})
+		} else {
+			GetEventsInstance().PlayStreams(true, []string{qt.Location}, nil)
+		}
+		q.DeleteAt(qt.Position)
+	case trackListPlaylist:
+		pl, pt, err := readPlaylistEntry(id)
+		if err != nil {
+			return dbus.MakeFailedError(err)
+		}
+		GetEventsInstance().TryPlayingFromBar(pl, pt.Position)
+	}
+	return nil
+}
+
+// Tracks returns the IDs of the entries in the track list.
+func (*TrackList) Tracks() []dbus.ObjectPath {
+	tracks := []dbus.ObjectPath{}
+
+	qts, _ := models.GetAllQueueTracks(models.GetActivePerspectiveIndex(), 0)
+	for _, qt := range qts {
+		tracks = append(tracks, trackListObjectPath(trackListQueue, qt.ID))
+	}
+
+	if pl := models.GetActiveEntry(); pl.ID > 0 {
+		pts, _ := pl.GetTracks(0)
+		for _, pt := range pts {
+			tracks = append(tracks, trackListObjectPath(trackListPlaylist, pt.ID))
+		}
+	}
+	return tracks
+}
+
+func (*TrackList) CanEditTracks() bool {
+	return true
+}
+
+// refresh compares the track list with the one last reported and emits
+// the corresponding signals, i.e., TrackAdded or TrackRemoved when only
+// entries were added or removed, and TrackListReplaced otherwise.
+func (tl *TrackList) refresh() {
+	tracks := tl.Tracks()
+
+	tl.mu.Lock()
+	prev := tl.tracks
+	tl.tracks = tracks
+	tl.mu.Unlock()
+
+	if slices.Equal(prev, tracks) {
+		return
+	}
+
+	switch {
+	case len(tracks) > len(prev) && isSubsequence(prev, tracks):
+		for i, id := range tracks {
+			if slices.Contains(prev, id) {
+				continue
+			}
+			after := dbus.ObjectPath(mpris.NoTrack)
+			if i > 0 {
+				after = tracks[i-1]
+			}
+			if m := trackListMetadata(id); m != nil {
+				tl.EmitTrackAdded(m, after)
+			}
+		}
+	case len(tracks) < len(prev) && isSubsequence(tracks, prev):
+		for _, id := range prev {
+			if !slices.Contains(tracks, id) {
+				tl.EmitTrackRemoved(id)
+			}
+		}
+	default:
+		current := dbus.ObjectPath(mpris.NoTrack)
+		if pt := instance.eng.pt.Load(); pt != nil {
+			id := trackListObjectPath(trackListPlaylist, pt.ID)
+			if slices.Contains(tracks, id) {
+				current = id
+			}
+		}
+		tl.EmitTrackListReplaced(tracks, current)
+	}
+
+	tl.SetTrackListProperty("Tracks", tracks)
+}
+
+// isSubsequence returns true if all the elements of sub are in s, in the
+// same order.
+func isSubsequence(sub, s []dbus.ObjectPath) bool {
+	i := 0
+	for j := 0; i < len(sub) && j < len(s); j++ {
+		if sub[i] == s[j] {
+			i++
+		}
+	}
+	return i == len(sub)
+}
+
+func readQueueEntry(id int64) (*models.Queue, *models.QueueTrack, error) {
+	qt := &models.QueueTrack{}
+	if err := qt.Read(id); err != nil {
+		return nil, nil, fmt.Errorf("Queue entry with ID=%v does not exist: %w", id, err)
+	}
+	if qt.Played {
+		return nil, nil, fmt.Errorf("Queue entry with ID=%v was already played", id)
+	}
+
+	q := &models.Queue{}
+	if err := q.Read(qt.QueueID); err != nil {
+		return nil, nil, err
+	}
+	return q, qt, nil
+}
+
+func readPlaylistEntry(id int64) (*models.Playlist, *models.PlaylistTrack, error) {
+	pt := &models.PlaylistTrack{}
+	if err := pt.Read(id); err != nil {
+		return nil, nil, fmt.Errorf("Playlist entry with ID=%v does not exist: %w", id, err)
+	}
+
+	pl := &models.Playlist{}
+	if err := pl.Read(pt.PlaylistID); err != nil {
+		return nil, nil, err
+	}
+	return pl, pt, nil
+}
+
+// trackListMetadata returns the metadata of the given entry, or nil if it
+// does not exist.
+func trackListMetadata(o dbus.ObjectPath) map[string]dbus.Variant {
+	kind, id, err := parseTrackListObjectPath(o)
+	if err != nil {
+		return nil
+	}
+
+	switch kind {
+	case trackListQueue:
+		qt := &models.QueueTrack{}
+		if err := qt.Read(id); err != nil {
+			return nil
+		}
+		var t *models.Track
+		if qt.TrackID > 0 {
+			t = &models.Track{}
+			if err := t.Read(qt.TrackID); err != nil {
+				t = nil
+			}
+		}
+		return trackMetadata(o, t, qt.Location)
+	case trackListPlaylist:
+		pt := &models.PlaylistTrack{}
+		if err := pt.Read(id); err != nil {
+			return nil
+		}
+		return trackMetadata(o, &pt.Track, pt.Track.Location)
+	}
+	return nil
+}
+
+// trackListObjectPath returns the MPRIS track ID for the given entry.
+func trackListObjectPath(kind string, id int64) dbus.ObjectPath {
+	return dbus.ObjectPath(fmt.Sprintf("%s/%s/%d", mpris.TrackPathPrefix, kind, id))
+}
+
+func parseTrackListObjectPath(o dbus.ObjectPath) (kind string, id int64, err error) {
+	rest, ok := strings.CutPrefix(string(o), mpris.TrackPathPrefix+"/")
+	if ok {
+		var s string
+		kind, s, ok = strings.Cut(rest, "/")
+		if ok && (kind == trackListQueue || kind == trackListPlaylist) {
+			id, err = strconv.ParseInt(s, 10, 64)
+			if err == nil && id > 0 {
+				return
+			}
+		}
+	}
+
+	err = fmt.Errorf("Invalid track ID: %v", o)
+	return
+}
@@ -0,0 +1,55 @@
+package playback
+
+import (
+	"testing"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/jwmwalrus/m3u-etcetera/internal/mpris"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackList(t *testing.T) {
+	tests.SetupTest(t, fixturesDir("playback/tracklist"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	tl := newTrackList(&mpris.Instance{})
+	tl.Properties()
+
+	queued := trackListObjectPath(trackListQueue, 1)
+	assert.Equal(t, []dbus.ObjectPath{
+		queued,
+		trackListObjectPath(trackListPlaylist, 1),
+		trackListObjectPath(trackListPlaylist, 2),
+		trackListObjectPath(trackListPlaylist, 3),
+	}, tl.tracks, "queue first, then active playlist")
+
+	ms, derr := tl.GetTracksMetadata([]dbus.ObjectPath{
+		trackListObjectPath(trackListPlaylist, 2),
+		trackListObjectPath(trackListPlaylist, 99),
+		"/not/a/track",
+	})
+	require.Nil(t, derr)
+	require.Len(t, ms, 1, "invalid IDs are ignored")
+	assert.Equal(t, "second", ms[0]["xesam:title"].Value())
+
+	t.Run("remove from queue", func(t *testing.T) {
+		require.Nil(t, tl.RemoveTrack(queued))
+		tl.refresh()
+		assert.NotContains(t, tl.tracks, queued)
+		assert.Len(t, tl.tracks, 3)
+	})
+
+	t.Run("unsupported URI", func(t *testing.T) {
+		assert.NotNil(t, tl.AddTrack("file:///tmp/notes.txt", mpris.NoTrack, false))
+	})
+}
+
+func TestIsSubsequence(t *testing.T) {
+	s := []dbus.ObjectPath{"/a", "/b", "/c"}
+	assert.True(t, isSubsequence([]dbus.ObjectPath{"/a", "/c"}, s))
+	assert.True(t, isSubsequence(nil, s))
+	assert.False(t, isSubsequence([]dbus.ObjectPath{"/c", "/a"}, s))
+	assert.False(t, isSubsequence([]dbus.ObjectPath{"/d"}, s))
+}
@@ -0,0 +1,127 @@
+package scrobble
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+)
+
+// lastFMMaxBatch defines the maximum number of scrobbles per request.
+const lastFMMaxBatch = 50
+
+// lastFM implements the service interface for Last.fm, or a compatible
+// service.
+type lastFM struct {
+	url        string
+	apiKey     string
+	secret     string
+	sessionKey string
+}
+
+type lastFMError struct {
+	Error   int    `json:"error"`
+	Message string `json:"message"`
+}
+
+func (lf *lastFM) maxBatch() int {
+	return lastFMMaxBatch
+}
+
+func (lf *lastFM) nowPlaying(ctx context.Context, s *models.Scrobble) error {
+	params := url.Values{}
+	lf.addTrack(params, s, "")
+	return lf.call(ctx, "track.updateNowPlaying", params)
+}
+
+func (lf *lastFM) submit(ctx context.Context, ss []*models.Scrobble) error {
+	params := url.Values{}
+	for i, s := range ss {
+		suffix := "[" + strconv.Itoa(i) + "]"
+		lf.addTrack(params, s, suffix)
+		params.Set("timestamp"+suffix, strconv.FormatInt(time.Unix(0, s.PlayedAt).Unix(), 10))
+	}
+	return lf.call(ctx, "track.scrobble", params)
+}
+
+func (lf *lastFM) addTrack(params url.Values, s *models.Scrobble, suffix string) {
+	params.Set("artist"+suffix, s.Artist)
+	params.Set("track"+suffix, s.Title)
+	if s.Album != "" {
+		params.Set("album"+suffix, s.Album)
+	}
+	if s.Albumartist != "" {
+		params.Set("albumArtist"+suffix, s.Albumartist)
+	}
+	if s.Duration > 0 {
+		params.Set("duration"+suffix, strconv.Itoa(int(time.Duration(s.Duration).Seconds())))
+	}
+	if s.Tracknumber > 0 {
+		params.Set("trackNumber"+suffix, strconv.Itoa(s.Tracknumber))
+	}
+}
+
+func (lf *lastFM) call(ctx context.Context, method string, params url.Values) error {
+	params.Set("method", method)
+	params.Set("api_key", lf.apiKey)
+	params.Set("sk", lf.sessionKey)
+	params.Set("api_sig", lf.sign(params))
+	params.Set("format", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, lf.url,
+		strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+
+	lerr := lastFMError{}
+	if json.Unmarshal(body, &lerr) == nil && lerr.Error != 0 {
+		return fmt.Errorf("Last.fm error %d: %v", lerr.Error, lerr.Message)
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("Last.fm replied %v", res.Status)
+	}
+	return nil
+}
+
+// sign returns the signature of the given parameters, i.e., the MD5 sum
+// of all of them, sorted by name, followed by the shared secret.
+func (lf *lastFM) sign(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	sb := strings.Builder{}
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteString(params.Get(k))
+	}
+	sb.WriteString(lf.secret)
+
+	sum := md5.Sum([]byte(sb.String()))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,122 @@
+package scrobble
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+)
+
+// listenBrainzMaxBatch defines the maximum number of listens per request.
+const listenBrainzMaxBatch = 100
+
+// ListenBrainz listen types.
+const (
+	listenTypeSingle     = "single"
+	listenTypeImport     = "import"
+	listenTypePlayingNow = "playing_now"
+)
+
+// listenBrainz implements the service interface for ListenBrainz, or a
+// compatible service.
+type listenBrainz struct {
+	url   string
+	token string
+}
+
+type listenBrainzPayload struct {
+	ListenType string               `json:"listen_type"`
+	Payload    []listenBrainzListen `json:"payload"`
+}
+
+type listenBrainzListen struct {
+	ListenedAt    int64                `json:"listened_at,omitempty"`
+	TrackMetadata listenBrainzMetadata `json:"track_metadata"`
+}
+
+type listenBrainzMetadata struct {
+	ArtistName     string         `json:"artist_name"`
+	TrackName      string         `json:"track_name"`
+	ReleaseName    string         `json:"release_name,omitempty"`
+	AdditionalInfo map[string]any `json:"additional_info,omitempty"`
+}
+
+func (lb *listenBrainz) maxBatch() int {
+	return listenBrainzMaxBatch
+}
+
+func (lb *listenBrainz) nowPlaying(ctx context.Context, s *models.Scrobble) error {
+	listen := lb.toListen(s)
+	listen.ListenedAt = 0
+	return lb.post(ctx, listenBrainzPayload{
+		ListenType: listenTypePlayingNow,
+		Payload:    []listenBrainzListen{listen},
+	})
+}
+
+func (lb *listenBrainz) submit(ctx context.Context, ss []*models.Scrobble) error {
+	p := listenBrainzPayload{ListenType: listenTypeSingle}
+	if len(ss) > 1 {
+		p.ListenType = listenTypeImport
+	}
+	for _, s := range ss {
+		p.Payload = append(p.Payload, lb.toListen(s))
+	}
+	return lb.post(ctx, p)
+}
+
+func (lb *listenBrainz) toListen(s *models.Scrobble) listenBrainzListen {
+	info := map[string]any{
+		"submission_client": clientName,
+		"duration_ms":       time.Duration(s.Duration).Milliseconds(),
+	}
+	if s.Tracknumber > 0 {
+		info["tracknumber"] = s.Tracknumber
+	}
+	if s.Albumartist != "" && s.Albumartist != s.Artist {
+		info["release_artist_name"] = s.Albumartist
+	}
+
+	return listenBrainzListen{
+		ListenedAt: time.Unix(0, s.PlayedAt).Unix(),
+		TrackMetadata: listenBrainzMetadata{
+			ArtistName:     s.Artist,
+			TrackName:      s.Title,
+			ReleaseName:    s.Album,
+			AdditionalInfo: info,
+		},
+	}
+}
+
+func (lb *listenBrainz) post(ctx context.Context, p listenBrainzPayload) error {
+	bv, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	u := strings.TrimSuffix(lb.url, "/") + "/1/submit-listens"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(bv))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token "+lb.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("ListenBrainz replied %v: %s", res.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package scrobble
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+)
+
+// logRatingListened marks a track as listened to, in a .scrobbler.log.
+const logRatingListened = "L"
+
+// WriteLog writes the given scrobbles in the .scrobbler.log format, i.e.,
+// the Audioscrobbler portable player format, oldest first.
+func WriteLog(w io.Writer, ss []*models.Scrobble) error {
+	sb := strings.Builder{}
+	sb.WriteString("#AUDIOSCROBBLER/1.1\n")
+	sb.WriteString("#TZ/UTC\n")
+	sb.WriteString("#CLIENT/" + clientName + "\n")
+
+	sorted := slices.Clone(ss)
+	slices.SortStableFunc(sorted, func(a, b *models.Scrobble) int {
+		return cmp.Compare(a.PlayedAt, b.PlayedAt)
+	})
+
+	for _, s := range sorted {
+		tracknumber := ""
+		if s.Tracknumber > 0 {
+			tracknumber = strconv.Itoa(s.Tracknumber)
+		}
+
+		fields := []string{
+			s.Artist,
+			s.Album,
+			s.Title,
+			tracknumber,
+			strconv.Itoa(int(time.Duration(s.Duration).Seconds())),
+			logRatingListened,
+			strconv.FormatInt(time.Unix(0, s.PlayedAt).Unix(), 10),
+			"", // MusicBrainz track ID
+		}
+		for i := range fields {
+			fields[i] = strings.Map(func(r rune) rune {
+				if r == '\t' || r == '\n' || r == '\r' {
+					return ' '
+				}
+				return r
+			}, fields[i])
+		}
+		sb.WriteString(strings.Join(fields, "\t") + "\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// ExportLog writes the scrobbles in the outbox, to the .scrobbler.log at
+// the given location. Only those not submitted are exported, unless all
+// is true, and they are marked as submitted if markSubmitted is true.
+func ExportLog(location string, all, markSubmitted bool) (n int, err error) {
+	path, err := urlstr.URLToPath(location)
+	if err != nil {
+		return
+	}
+
+	ss := models.GetScrobbles(!all, 0)
+
+	f, err := os.Create(path)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	if err = WriteLog(f, ss); err != nil {
+		return
+	}
+	n = len(ss)
+
+	if markSubmitted {
+		ids := []int64{}
+		for _, s := range ss {
+			if s.Submitted == 0 {
+				ids = append(ids, s.ID)
+			}
+		}
+		if err = models.MarkScrobblesSubmitted(ids); err != nil {
+			err = fmt.Errorf("failed to mark exported scrobbles as submitted: %w", err)
+		}
+	}
+	return
+}
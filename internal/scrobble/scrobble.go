@@ -0,0 +1,241 @@
+// Package scrobble submits the tracks played to a scrobbling service,
+// i.e., one compatible with ListenBrainz or Last.fm. Plays are kept in an
+// outbox, so they are submitted once the service can be reached.
+package scrobble
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/base"
+	"github.com/jwmwalrus/m3u-etcetera/internal/config"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	rtc "github.com/jwmwalrus/rtcycler"
+)
+
+const (
+	// minDuration defines the minimum duration of a track to be scrobbled.
+	minDuration = 30 * time.Second
+
+	// maxThreshold defines the time after which a track is scrobbled,
+	// even if it was not played halfway through.
+	maxThreshold = 4 * time.Minute
+
+	// requestTimeout defines how long to wait for the service to reply.
+	requestTimeout = 30 * time.Second
+
+	// clientName identifies the application to the service.
+	clientName = base.AppDirName
+)
+
+// service defines the interface of a scrobbling service.
+type service interface {
+	// nowPlaying reports the track that started playing.
+	nowPlaying(ctx context.Context, s *models.Scrobble) error
+
+	// submit submits the given scrobbles, that must be at most maxBatch.
+	submit(ctx context.Context, ss []*models.Scrobble) error
+
+	maxBatch() int
+}
+
+var (
+	quit     chan struct{}
+	submitMu sync.Mutex // one submission at a time
+	trigger  = make(chan struct{}, 1)
+
+	unloader = &rtc.Unloader{
+		Description: "StopScrobbler",
+		Callback: func() error {
+			if quit != nil {
+				close(quit)
+				quit = nil
+			}
+			return nil
+		},
+	}
+)
+
+// Start starts submitting the outbox to the configured service, if
+// scrobbling is enabled, retrying periodically.
+func Start() *rtc.Unloader {
+	conf := base.Conf.Server.Scrobble
+	if !conf.Enabled {
+		return unloader
+	}
+
+	slog.Info("Starting scrobbler", "service", conf.Service, "url", conf.URL)
+
+	quit = make(chan struct{})
+	go run(quit, time.Duration(conf.RetryInterval)*time.Second)
+	return unloader
+}
+
+// Submit submits the outbox right away, instead of waiting for the next
+// retry.
+func Submit() error {
+	if !base.Conf.Server.Scrobble.Enabled {
+		return fmt.Errorf("scrobbling is not enabled")
+	}
+	if len(trigger) < 1 {
+		trigger <- struct{}{}
+	}
+	return nil
+}
+
+// MustScrobble returns true if a track of the given duration, played up
+// to the given position, qualifies as a scrobble, i.e., it is longer than
+// 30 seconds and was played halfway through or for 4 minutes.
+func MustScrobble(position, duration int64) bool {
+	if time.Duration(duration) <= minDuration {
+		return false
+	}
+	return time.Duration(position) >= min(time.Duration(duration/2), maxThreshold)
+}
+
+// Played adds the given track to the outbox, if scrobbling is enabled and
+// it was played long enough.
+func Played(trackID, position int64) {
+	if !base.Conf.Server.Scrobble.Enabled || trackID == 0 {
+		return
+	}
+
+	t := &models.Track{}
+	if err := t.Read(trackID); err != nil {
+		slog.Error("Failed to read track to scrobble", "track_id", trackID, "error", err)
+		return
+	}
+
+	if !MustScrobble(position, t.Duration) {
+		slog.Debug("Track not played long enough to be scrobbled", "track_id", trackID)
+		return
+	}
+
+	playedAt := time.Now().Add(-time.Duration(position))
+	if _, err := models.AddScrobble(t, playedAt); err != nil {
+		slog.Error("Failed to add scrobble", "track_id", trackID, "error", err)
+	}
+}
+
+// NowPlaying reports the given track as playing, if scrobbling is enabled.
+// Since now-playing updates are not kept, failures are only logged.
+func NowPlaying(t *models.Track) {
+	if !base.Conf.Server.Scrobble.Enabled ||
+		t == nil ||
+		time.Duration(t.Duration) <= minDuration {
+		return
+	}
+
+	svc, err := newService()
+	if err != nil {
+		slog.Error("Failed to create scrobbling service", "error", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	s := &models.Scrobble{
+		TrackID:     t.ID,
+		Artist:      t.Artist,
+		Title:       t.Title,
+		Album:       t.Album,
+		Albumartist: t.Albumartist,
+		Tracknumber: t.Tracknumber,
+		Duration:    t.Duration,
+	}
+	if s.Artist == "" {
+		s.Artist = t.Albumartist
+	}
+	if err := svc.nowPlaying(ctx, s); err != nil {
+		slog.Warn("Failed to send now-playing update", "track_id", t.ID, "error", err)
+	}
+}
+
+func newService() (service, error) {
+	conf := base.Conf.Server.Scrobble
+	switch conf.Service {
+	case config.ScrobbleListenBrainz:
+		if conf.Token == "" {
+			return nil, fmt.Errorf("a ListenBrainz token is required")
+		}
+		return &listenBrainz{url: conf.URL, token: conf.Token}, nil
+	case config.ScrobbleLastFM:
+		if conf.APIKey == "" || conf.Secret == "" || conf.SessionKey == "" {
+			return nil, fmt.Errorf("a Last.fm API key, secret and session key are required")
+		}
+		return &lastFM{
+			url:        conf.URL,
+			apiKey:     conf.APIKey,
+			secret:     conf.Secret,
+			sessionKey: conf.SessionKey,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported scrobbling service: %v", conf.Service)
+	}
+}
+
+func run(quit chan struct{}, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	flush()
+	for {
+		select {
+		case <-quit:
+			slog.Info("Scrobbler stopped")
+			return
+		case <-models.ScrobbleQueued:
+		case <-trigger:
+		case <-t.C:
+		}
+		flush()
+	}
+}
+
+// flush submits the pending scrobbles, in batches, until there are no
+// more or the service fails. In the latter case, the remaining ones wait
+// for the next retry.
+func flush() {
+	submitMu.Lock()
+	defer submitMu.Unlock()
+
+	svc, err := newService()
+	if err != nil {
+		slog.Error("Failed to create scrobbling service", "error", err)
+		return
+	}
+
+	for {
+		ss := models.GetPendingScrobbles(svc.maxBatch())
+		if len(ss) == 0 {
+			return
+		}
+
+		ids := make([]int64, len(ss))
+		for i := range ss {
+			ids[i] = ss[i].ID
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		err := svc.submit(ctx, ss)
+		cancel()
+
+		if err != nil {
+			slog.Warn("Failed to submit scrobbles; will retry", "count", len(ss), "error", err)
+			if err := models.MarkScrobblesFailed(ids, err.Error()); err != nil {
+				slog.Error("Failed to record scrobble attempts", "error", err)
+			}
+			return
+		}
+
+		slog.Info("Scrobbles submitted", "count", len(ss))
+		if err := models.MarkScrobblesSubmitted(ids); err != nil {
+			slog.Error("Failed to mark scrobbles as submitted", "error", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package scrobble
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMustScrobble(t *testing.T) {
+	tests := []struct {
+		name     string
+		position time.Duration
+		duration time.Duration
+		want     bool
+	}{
+		{"too short", 30 * time.Second, 30 * time.Second, false},
+		{"not halfway", 59 * time.Second, 2 * time.Minute, false},
+		{"halfway", time.Minute, 2 * time.Minute, true},
+		{"four minutes", 4 * time.Minute, 20 * time.Minute, true},
+		{"under four minutes", 3 * time.Minute, 20 * time.Minute, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, MustScrobble(int64(tc.position), int64(tc.duration)))
+		})
+	}
+}
+
+func TestWriteLog(t *testing.T) {
+	ss := []*models.Scrobble{
+		{
+			Artist:   "Some Band",
+			Title:    "Second\tSong",
+			Duration: int64(95 * time.Second),
+			PlayedAt: time.Unix(1700000300, 0).UnixNano(),
+		},
+		{
+			Artist:      "Some Band",
+			Album:       "Some Album",
+			Title:       "First Song",
+			Tracknumber: 1,
+			Duration:    int64(252 * time.Second),
+			PlayedAt:    time.Unix(1700000000, 0).UnixNano(),
+		},
+	}
+
+	sb := strings.Builder{}
+	require.NoError(t, WriteLog(&sb, ss))
+
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+	require.Len(t, lines, 5)
+	assert.Equal(t, "#AUDIOSCROBBLER/1.1", lines[0])
+	assert.Equal(t, "#TZ/UTC", lines[1])
+	assert.Equal(t, "#CLIENT/"+clientName, lines[2])
+	assert.Equal(t, "Some Band\tSome Album\tFirst Song\t1\t252\tL\t1700000000\t", lines[3])
+	assert.Equal(t, "Some Band\t\tSecond Song\t\t95\tL\t1700000300\t", lines[4])
+}
+
+func TestListenBrainzSubmit(t *testing.T) {
+	var got listenBrainzPayload
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/1/submit-listens", r.URL.Path)
+		assert.Equal(t, "Token secret-token", r.Header.Get("Authorization"))
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer ts.Close()
+
+	lb := &listenBrainz{url: ts.URL, token: "secret-token"}
+	err := lb.submit(context.Background(), []*models.Scrobble{
+		{Artist: "A", Title: "One", PlayedAt: time.Unix(10, 0).UnixNano()},
+		{Artist: "A", Title: "Two", PlayedAt: time.Unix(20, 0).UnixNano()},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, listenTypeImport, got.ListenType)
+	require.Len(t, got.Payload, 2)
+	assert.Equal(t, int64(10), got.Payload[0].ListenedAt)
+	assert.Equal(t, "Two", got.Payload[1].TrackMetadata.TrackName)
+}
+
+func TestListenBrainzError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, `{"error":"Invalid token"}`, http.StatusUnauthorized)
+	}))
+	defer ts.Close()
+
+	lb := &listenBrainz{url: ts.URL, token: "wrong"}
+	err := lb.submit(context.Background(), []*models.Scrobble{{Artist: "A", Title: "One"}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid token")
+}
+
+func TestLastFMSubmit(t *testing.T) {
+	var got url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		got = r.PostForm
+		w.Write([]byte(`{"scrobbles":{}}`))
+	}))
+	defer ts.Close()
+
+	lf := &lastFM{url: ts.URL, apiKey: "key", secret: "shh", sessionKey: "sk"}
+	err := lf.submit(context.Background(), []*models.Scrobble{
+		{Artist: "A", Title: "One", Album: "X", PlayedAt: time.Unix(10, 0).UnixNano()},
+		{Artist: "B", Title: "Two", PlayedAt: time.Unix(20, 0).UnixNano()},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "track.scrobble", got.Get("method"))
+	assert.Equal(t, "One", got.Get("track[0]"))
+	assert.Equal(t, "X", got.Get("album[0]"))
+	assert.Equal(t, "20", got.Get("timestamp[1]"))
+	assert.Equal(t, "json", got.Get("format"))
+
+	sig := got.Get("api_sig")
+	got.Del("api_sig")
+	got.Del("format")
+	assert.Equal(t, lf.sign(got), sig, "signature excludes format")
+}
+
+func TestLastFMError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"error":9,"message":"Invalid session key"}`))
+	}))
+	defer ts.Close()
+
+	lf := &lastFM{url: ts.URL, apiKey: "key", secret: "shh", sessionKey: "expired"}
+	err := lf.nowPlaying(context.Background(), &models.Scrobble{Artist: "A", Title: "One"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Invalid session key")
+}
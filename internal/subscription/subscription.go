@@ -126,11 +126,11 @@ func Broadcast(st Type, es ...Event) {
 	defer subscriptors.mu.Unlock()
 	for i := range subscriptors.s {
 		if subscriptors.s[i].st == st {
-			go func(i int) {
+			go func(ch chan Event) {
 				for _, x := range list {
-					subscriptors.s[i].Event <- x
+					ch <- x
 				}
-			}(i)
+			}(subscriptors.s[i].Event)
 		}
 	}
 }
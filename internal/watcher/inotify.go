@@ -0,0 +1,316 @@
+package watcher
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/jwmwalrus/bnp/onerror"
+	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// debounceDelay defines how long a path must be left alone before its
+	// changes are applied.
+	debounceDelay = 2 * time.Second
+
+	watchMask = unix.IN_CREATE | unix.IN_CLOSE_WRITE | unix.IN_DELETE |
+		unix.IN_MOVED_FROM | unix.IN_MOVED_TO | unix.IN_ONLYDIR
+)
+
+// watcher watches the files under a collection's location.
+type watcher struct {
+	id       int64  // collection ID
+	location string // as watched
+	fd       int
+	file     *os.File // wraps fd, so that reading can be interrupted
+	delay    time.Duration
+	quit     chan struct{}
+	wg       sync.WaitGroup // for the reading and flushing goroutines
+
+	mu     sync.Mutex
+	dirs   map[int32]string     // by watch descriptor
+	dirty  map[string]time.Time // by path, when last changed
+	moves  map[uint32]move      // by cookie, waiting for their destination
+	moved  []move               // waiting to be applied
+	rescan bool                 // if events were lost
+}
+
+// move defines a file or directory moved within the collection.
+type move struct {
+	from, to string
+	isDir    bool
+	at       time.Time
+}
+
+func newWatcher(c *models.Collection, delay time.Duration) (*watcher, error) {
+	root, err := urlstr.URLToPath(c.Location)
+	if err != nil {
+		return nil, err
+	}
+
+	fd, err := unix.InotifyInit1(unix.IN_CLOEXEC | unix.IN_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &watcher{
+		id:       c.ID,
+		location: c.Location,
+		fd:       fd,
+		file:     os.NewFile(uintptr(fd), "inotify"),
+		delay:    delay,
+		quit:     make(chan struct{}),
+		dirs:     map[int32]string{},
+		dirty:    map[string]time.Time{},
+		moves:    map[uint32]move{},
+	}
+
+	if err = w.addTree(root); err != nil {
+		w.file.Close()
+		return nil, err
+	}
+
+	slog.Info("Watching collection", "collection", c.Name, "directories", len(w.dirs))
+
+	w.wg.Add(2)
+	go w.read()
+	go w.flush()
+	return w, nil
+}
+
+// stop stops watching, and returns once the pending changes are no longer
+// being applied.
+func (w *watcher) stop() {
+	close(w.quit)
+	w.file.Close()
+	w.wg.Wait()
+}
+
+// addTree watches the given directory and all the ones under it. Only
+// failing to watch the given directory is an error.
+func (w *watcher) addTree(dir string) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			slog.Warn("Failed to walk directory", "path", path, "error", err)
+			return nil
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		wd, err := unix.InotifyAddWatch(w.fd, path, watchMask)
+		if err != nil {
+			if path == dir {
+				return err
+			}
+			slog.Warn("Failed to watch directory", "path", path, "error", err)
+			return filepath.SkipDir
+		}
+		w.dirs[int32(wd)] = path
+		return nil
+	})
+}
+
+func (w *watcher) read() {
+	defer w.wg.Done()
+
+	buf := make([]byte, 64*(unix.SizeofInotifyEvent+unix.NAME_MAX+1))
+	for {
+		n, err := w.file.Read(buf)
+		if err != nil {
+			if !errors.Is(err, os.ErrClosed) {
+				slog.Error("Failed to read inotify events", "error", err)
+			}
+			return
+		}
+
+		for off := 0; off+unix.SizeofInotifyEvent <= n; {
+			e := (*unix.InotifyEvent)(unsafe.Pointer(&buf[off]))
+			off += unix.SizeofInotifyEvent
+
+			name := strings.TrimRight(string(buf[off:off+int(e.Len)]), "\x00")
+			off += int(e.Len)
+
+			w.handle(e, name)
+		}
+	}
+}
+
+func (w *watcher) handle(e *unix.InotifyEvent, name string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if e.Mask&unix.IN_Q_OVERFLOW != 0 {
+		w.rescan = true
+		return
+	}
+
+	dir, ok := w.dirs[e.Wd]
+	if !ok {
+		return
+	}
+
+	if e.Mask&unix.IN_IGNORED != 0 {
+		delete(w.dirs, e.Wd)
+		return
+	}
+
+	now := time.Now()
+	path := filepath.Join(dir, name)
+	isDir := e.Mask&unix.IN_ISDIR != 0
+
+	switch {
+	case e.Mask&unix.IN_MOVED_FROM != 0:
+		w.moves[e.Cookie] = move{from: path, isDir: isDir, at: now}
+
+	case e.Mask&unix.IN_MOVED_TO != 0:
+		m, ok := w.moves[e.Cookie]
+		if !ok {
+			// moved into the collection
+			if isDir {
+				onerror.Warn(w.addTree(path))
+			}
+			w.dirty[path] = now
+			return
+		}
+
+		delete(w.moves, e.Cookie)
+		m.to = path
+		w.moved = append(w.moved, m)
+		if isDir {
+			w.moveDirs(m.from, m.to)
+		}
+		for p, at := range w.dirty {
+			if moved, ok := movedPath(p, m.from, m.to); ok {
+				delete(w.dirty, p)
+				w.dirty[moved] = at
+			}
+		}
+		w.dirty[path] = now
+
+	case isDir && e.Mask&unix.IN_CREATE != 0:
+		// files might have been created before the directory was watched
+		onerror.Warn(w.addTree(path))
+		w.dirty[path] = now
+
+	default:
+		w.dirty[path] = now
+	}
+}
+
+// moveDirs updates the paths of the directories under the one moved.
+func (w *watcher) moveDirs(from, to string) {
+	for wd, p := range w.dirs {
+		if moved, ok := movedPath(p, from, to); ok {
+			w.dirs[wd] = moved
+		}
+	}
+}
+
+// unwatchDirs stops watching the directories under the given one.
+func (w *watcher) unwatchDirs(dir string) {
+	for wd, p := range w.dirs {
+		if _, ok := movedPath(p, dir, dir); ok {
+			_, err := unix.InotifyRmWatch(w.fd, uint32(wd))
+			onerror.Warn(err)
+			delete(w.dirs, wd)
+		}
+	}
+}
+
+func (w *watcher) flush() {
+	defer w.wg.Done()
+
+	t := time.NewTicker(w.delay / 4)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-w.quit:
+			return
+		case <-t.C:
+		}
+		w.apply()
+	}
+}
+
+// apply applies the pending moves, and the changes to the paths left alone
+// for long enough.
+func (w *watcher) apply() {
+	now := time.Now()
+
+	w.mu.Lock()
+	for cookie, m := range w.moves {
+		if now.Sub(m.at) < w.delay {
+			continue
+		}
+
+		// moved out of the collection
+		delete(w.moves, cookie)
+		if m.isDir {
+			w.unwatchDirs(m.from)
+		}
+		w.dirty[m.from] = m.at
+	}
+
+	paths := []string{}
+	for p, at := range w.dirty {
+		if now.Sub(at) >= w.delay {
+			paths = append(paths, p)
+			delete(w.dirty, p)
+		}
+	}
+
+	moved, rescan := w.moved, w.rescan
+	w.moved, w.rescan = nil, false
+	w.mu.Unlock()
+
+	if len(moved) == 0 && len(paths) == 0 && !rescan {
+		return
+	}
+
+	c := &models.Collection{}
+	if err := c.Read(w.id); err != nil {
+		slog.Error("Failed to read watched collection", "id", w.id, "error", err)
+		return
+	}
+
+	if rescan {
+		slog.Warn("Collection events were lost, rescanning", "collection", c.Name)
+		c.Scan(false)
+		return
+	}
+
+	for _, m := range moved {
+		onerror.Warn(c.MovePath(m.from, m.to))
+	}
+
+	slices.Sort(paths)
+	for _, p := range paths {
+		onerror.Warn(c.SyncPath(p))
+	}
+}
+
+// movedPath returns the given path after moving from to to, and true if
+// the path is from or under it.
+func movedPath(path, from, to string) (string, bool) {
+	rest, ok := strings.CutPrefix(path, from)
+	if !ok || rest != "" && rest[0] != filepath.Separator {
+		return path, false
+	}
+	return to + rest, true
+}
@@ -0,0 +1,129 @@
+// Package watcher applies the changes to the files of watched collections
+// as they happen, through inotify, so that scanning them is needed only
+// for recovery.
+package watcher
+
+import (
+	"log/slog"
+	"sync"
+
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+	rtc "github.com/jwmwalrus/rtcycler"
+)
+
+var (
+	mu       sync.Mutex
+	quit     chan struct{}
+	watchers = map[int64]*watcher{} // by collection ID
+
+	unloader = &rtc.Unloader{
+		Description: "StopCollectionWatchers",
+		Callback: func() error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if quit != nil {
+				close(quit)
+				quit = nil
+			}
+
+			for id, w := range watchers {
+				w.stop()
+				delete(watchers, id)
+			}
+			return nil
+		},
+	}
+)
+
+// Start starts watching the collections that are meant to be watched, and
+// follows the changes to their settings.
+func Start() *rtc.Unloader {
+	for _, c := range models.GetAllCollections() {
+		update(c)
+	}
+
+	mu.Lock()
+	quit = make(chan struct{})
+	go run(quit)
+	mu.Unlock()
+
+	return unloader
+}
+
+func run(quit chan struct{}) {
+	s, _ := subscription.Subscribe(subscription.ToCollectionStoreEvent)
+	defer s.Unsubscribe()
+
+	for {
+		select {
+		case <-quit:
+			return
+		case e := <-s.Event:
+			if s.MustUnsubscribe(e) {
+				return
+			}
+
+			c, ok := e.Data.(*models.Collection)
+			if !ok {
+				continue
+			}
+
+			if models.CollectionEvent(e.Idx) == models.CollectionEventItemRemoved {
+				remove(c.ID)
+				continue
+			}
+
+			// the event's collection might be in use elsewhere
+			coll := &models.Collection{}
+			if err := coll.Read(c.ID); err != nil {
+				remove(c.ID)
+				continue
+			}
+			update(coll)
+		}
+	}
+}
+
+// update starts or stops watching the given collection, according to its
+// settings.
+func update(c *models.Collection) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	w, ok := watchers[c.ID]
+	if ok {
+		if mustWatch(c) && w.location == c.Location {
+			return
+		}
+		w.stop()
+		delete(watchers, c.ID)
+	}
+
+	if !mustWatch(c) {
+		return
+	}
+
+	w, err := newWatcher(c, debounceDelay)
+	if err != nil {
+		slog.Error("Failed to watch collection", "collection", c.Name, "error", err)
+		return
+	}
+	watchers[c.ID] = w
+}
+
+// remove stops watching the collection with the given ID.
+func remove(id int64) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if w, ok := watchers[id]; ok {
+		w.stop()
+		delete(watchers, id)
+	}
+}
+
+func mustWatch(c *models.Collection) bool {
+	return c.Watched && !c.Disabled && !c.Remote && !c.Hidden
+}
@@ -0,0 +1,189 @@
+package watcher
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jwmwalrus/bnp/urlstr"
+	"github.com/jwmwalrus/m3u-etcetera/internal/database/models"
+	"github.com/jwmwalrus/m3u-etcetera/internal/subscription"
+	"github.com/jwmwalrus/m3u-etcetera/internal/tests"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+const (
+	testDelay   = 100 * time.Millisecond
+	waitTimeout = 5 * time.Second
+	waitTick    = 20 * time.Millisecond
+)
+
+type fixturesDir string
+
+func (fd fixturesDir) FixturesDir() string {
+	return string(fd)
+}
+
+func TestWatcher(t *testing.T) {
+	db := tests.SetupTest(t, fixturesDir("watcher/collection"))
+	t.Cleanup(func() { tests.TeardownTest(t) })
+
+	dir, outside := t.TempDir(), t.TempDir()
+	audio, err := os.ReadFile(filepath.Join("..", "..", "data", "testing", "audio1", "track01.ogg"))
+	require.NoError(t, err)
+
+	c := &models.Collection{}
+	require.NoError(t, c.Read(3))
+	c.Location, err = urlstr.PathToURL(dir)
+	require.NoError(t, err)
+	require.NoError(t, c.Save())
+
+	items := make(chan subscription.Event, 100)
+
+	s, _ := subscription.Subscribe(subscription.ToCollectionStoreEvent)
+	t.Cleanup(s.Unsubscribe)
+	go func() {
+		for e := range s.Event {
+			if _, ok := e.Data.(*models.Track); ok {
+				items <- e
+			}
+		}
+	}()
+
+	w, err := newWatcher(c, testDelay)
+	require.NoError(t, err)
+	t.Cleanup(w.stop)
+
+	locationOf := func(name string) string {
+		location, err := urlstr.PathToURLUnchecked(filepath.Join(dir, name))
+		require.NoError(t, err)
+		return location
+	}
+
+	// findTrack returns the track at the given path in dir, if any
+	findTrack := func(name string) *models.Track {
+		location := locationOf(name)
+
+		tr := &models.Track{}
+		if db.Where("location = ?", location).First(tr).Error != nil {
+			return nil
+		}
+		return tr
+	}
+
+	waitForTrack := func(t *testing.T, name string) *models.Track {
+		var tr *models.Track
+		require.Eventually(t, func() bool {
+			tr = findTrack(name)
+			return tr != nil
+		}, waitTimeout, waitTick, "track %v was not added", name)
+		return tr
+	}
+
+	waitForNoTrack := func(t *testing.T, name string) {
+		require.Eventually(t, func() bool {
+			return findTrack(name) == nil
+		}, waitTimeout, waitTick, "track %v was not removed", name)
+	}
+
+	// waitForEvent returns the track of the first item event of the given
+	// kind for the given path in dir, skipping any other events
+	waitForEvent := func(t *testing.T, ce models.CollectionEvent, name string) *models.Track {
+		location := locationOf(name)
+		timeout := time.After(waitTimeout)
+		for {
+			select {
+			case e := <-items:
+				tr := e.Data.(*models.Track)
+				if models.CollectionEvent(e.Idx) == ce && tr.Location == location {
+					return tr
+				}
+			case <-timeout:
+				require.FailNow(t, "missing event", "%v for %v", ce, name)
+			}
+		}
+	}
+
+	var id int64
+
+	t.Run("created files are added", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "a.ogg"), audio, 0644))
+		id = waitForTrack(t, "a.ogg").ID
+		assert.Equal(t, id, waitForEvent(t, models.CollectionEventItemAdded, "a.ogg").ID)
+	})
+
+	t.Run("renamed files keep their track", func(t *testing.T) {
+		require.NoError(t, os.Rename(filepath.Join(dir, "a.ogg"), filepath.Join(dir, "b.ogg")))
+		assert.Equal(t, id, waitForTrack(t, "b.ogg").ID)
+		assert.Equal(t, id, waitForEvent(t, models.CollectionEventItemChanged, "b.ogg").ID)
+		assert.Nil(t, findTrack("a.ogg"))
+	})
+
+	t.Run("modified files are re-tagged", func(t *testing.T) {
+		tr := findTrack("b.ogg")
+		require.NoError(t, db.Model(tr).Update("title", "stale").Error)
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "b.ogg"), audio, 0644))
+		require.Eventually(t, func() bool {
+			tr := findTrack("b.ogg")
+			return tr != nil && tr.Title != "stale"
+		}, waitTimeout, waitTick)
+		assert.Equal(t, id, findTrack("b.ogg").ID)
+	})
+
+	t.Run("files in new directories are added", func(t *testing.T) {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "c.ogg"), audio, 0644))
+		waitForTrack(t, filepath.Join("sub", "c.ogg"))
+	})
+
+	t.Run("renamed directories keep their tracks", func(t *testing.T) {
+		subID := findTrack(filepath.Join("sub", "c.ogg")).ID
+		require.NoError(t, os.Rename(filepath.Join(dir, "sub"), filepath.Join(dir, "other")))
+		assert.Equal(t, subID, waitForTrack(t, filepath.Join("other", "c.ogg")).ID)
+
+		// the directory is still watched under its new name
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "other", "d.ogg"), audio, 0644))
+		waitForTrack(t, filepath.Join("other", "d.ogg"))
+	})
+
+	t.Run("deleted files are removed", func(t *testing.T) {
+		require.NoError(t, os.Remove(filepath.Join(dir, "b.ogg")))
+		waitForNoTrack(t, "b.ogg")
+		assert.Equal(t, id, waitForEvent(t, models.CollectionEventItemRemoved, "b.ogg").ID)
+
+		err := db.First(&models.Track{}, id).Error
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	})
+
+	t.Run("files moved out are removed", func(t *testing.T) {
+		require.NoError(t, os.Rename(filepath.Join(dir, "other"), filepath.Join(outside, "other")))
+		waitForNoTrack(t, filepath.Join("other", "c.ogg"))
+		waitForNoTrack(t, filepath.Join("other", "d.ogg"))
+	})
+}
+
+func TestMovedPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+		ok   bool
+	}{
+		{"same", "/music/a", "/other/a", true},
+		{"under", "/music/a/b.ogg", "/other/a/b.ogg", true},
+		{"sibling", "/music/ab.ogg", "/music/ab.ogg", false},
+		{"outside", "/videos/a", "/videos/a", false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := movedPath(tc.path, "/music/a", "/other/a")
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.ok, ok)
+		})
+	}
+}
@@ -4,13 +4,18 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"slices"
 	"strconv"
+	"strings"
+	"time"
 
+	"github.com/jwmwalrus/bnp/onerror"
 	"github.com/jwmwalrus/bnp/urlstr"
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
 	"github.com/rodaine/table"
 	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -66,6 +71,11 @@ func Collection() *cli.Command {
 						Aliases: []string{"r"},
 						Usage:   "collection is remote",
 					},
+					&cli.BoolFlag{
+						Name:    "watch",
+						Aliases: []string{"w"},
+						Usage:   "apply changes to the collection's files as they happen",
+					},
 					&cli.StringFlag{
 						Name:  "descr",
 						Usage: "collection's `DESCRIPTION`",
@@ -113,6 +123,15 @@ func Collection() *cli.Command {
 						Aliases: []string{"r"},
 						Usage:   "collection is remote",
 					},
+					&cli.BoolFlag{
+						Name:    "watch",
+						Aliases: []string{"w"},
+						Usage:   "apply changes to the collection's files as they happen",
+					},
+					&cli.BoolFlag{
+						Name:  "unwatch",
+						Usage: "stop applying changes to the collection's files",
+					},
 					&cli.StringFlag{
 						Name:  "name",
 						Usage: "rename collection to the given `NAME` (shall be unique)",
@@ -127,12 +146,29 @@ func Collection() *cli.Command {
 				Name:        "scan",
 				Usage:       "Scans a collection",
 				ArgsUsage:   "ID",
-				Description: "Scan collection with the given `ID` for new and deleted tracks.",
+				Description: "Scan collection with the given `ID` for new and deleted tracks, or control its running scan.",
 				Action:      collectionScanAction,
 				Flags: []cli.Flag{
 					&cli.BoolFlag{
 						Name:  "update-tags",
-						Usage: "update the tags of tracks already in the collection",
+						Usage: "update the tags of touched files, even if their content hash did not change",
+					},
+					&cli.BoolFlag{
+						Name:    "follow",
+						Aliases: []string{"f"},
+						Usage:   "keep displaying the scanning progress until done",
+					},
+					&cli.BoolFlag{
+						Name:  "cancel",
+						Usage: "cancel the running scan",
+					},
+					&cli.BoolFlag{
+						Name:  "pause",
+						Usage: "pause the running scan",
+					},
+					&cli.BoolFlag{
+						Name:  "resume",
+						Usage: "resume the paused scan",
 					},
 				},
 			},
@@ -174,7 +210,7 @@ func collectionAction(ctx context.Context, c *cli.Command) (err error) {
 		return
 	}
 
-	tbl := table.New("ID", "Name", "Disabled", "Remote", "Tracks", "Location")
+	tbl := table.New("ID", "Name", "Disabled", "Remote", "Watched", "Tracks", "Location")
 	for _, i := range res.Collections {
 		var st string
 		if i.Scanned != 100 {
@@ -182,7 +218,7 @@ func collectionAction(ctx context.Context, c *cli.Command) (err error) {
 		} else {
 			st = strconv.FormatInt(i.Tracks, 10)
 		}
-		tbl.AddRow(i.Id, i.Name, i.Disabled, i.Remote, st, i.Location)
+		tbl.AddRow(i.Id, i.Name, i.Disabled, i.Remote, i.Watched, st, i.Location)
 	}
 	tbl.Print()
 
@@ -219,7 +255,7 @@ func collectionInfoAction(ctx context.Context, c *cli.Command) (err error) {
 		return
 	}
 
-	tbl := table.New("ID", "Name", "Disabled", "Remote", "Tracks", "Location")
+	tbl := table.New("ID", "Name", "Disabled", "Remote", "Watched", "Tracks", "Location")
 	coll := res.Collection
 	var st string
 	if coll.Scanned != 100 {
@@ -227,7 +263,7 @@ func collectionInfoAction(ctx context.Context, c *cli.Command) (err error) {
 	} else {
 		st = strconv.FormatInt(coll.Tracks, 10)
 	}
-	tbl.AddRow(coll.Id, coll.Name, coll.Disabled, coll.Remote, st, coll.Location)
+	tbl.AddRow(coll.Id, coll.Name, coll.Disabled, coll.Remote, coll.Watched, st, coll.Location)
 	tbl.Print()
 
 	return
@@ -252,6 +288,7 @@ func collectionAddAction(ctx context.Context, c *cli.Command) (err error) {
 		Location:    rest[1],
 		Disabled:    c.Bool("disabled"),
 		Remote:      c.Bool("remote"),
+		Watched:     c.Bool("watch"),
 		Description: c.String("descr"),
 	}
 
@@ -332,6 +369,12 @@ func collectionUpdateAction(ctx context.Context, c *cli.Command) (err error) {
 	if c.Bool("remote") {
 		req.MakeRemote = true
 	}
+	if c.Bool("watch") {
+		req.Watch = true
+	}
+	if c.Bool("unwatch") {
+		req.Unwatch = true
+	}
 
 	cl := newCollectionSvcClient(cc)
 	_, err = cl.UpdateCollection(context.Background(), req)
@@ -349,9 +392,19 @@ func collectionScanAction(ctx context.Context, c *cli.Command) (err error) {
 		return
 	}
 
-	req := &m3uetcpb.ScanCollectionRequest{
-		Id:         id,
-		UpdateTags: c.Bool("update-tags"),
+	var actions []m3uetcpb.ScanAction
+	if c.Bool("cancel") {
+		actions = append(actions, m3uetcpb.ScanAction_SA_CANCEL)
+	}
+	if c.Bool("pause") {
+		actions = append(actions, m3uetcpb.ScanAction_SA_PAUSE)
+	}
+	if c.Bool("resume") {
+		actions = append(actions, m3uetcpb.ScanAction_SA_RESUME)
+	}
+	if len(actions) > 1 {
+		err = fmt.Errorf("Flags cancel, pause and resume are mutually exclusive")
+		return
 	}
 
 	cc, err := getClientConn()
@@ -361,6 +414,30 @@ func collectionScanAction(ctx context.Context, c *cli.Command) (err error) {
 	defer cc.Close()
 
 	cl := newCollectionSvcClient(cc)
+
+	if len(actions) == 1 {
+		req := &m3uetcpb.ControlCollectionScanRequest{
+			Id:     id,
+			Action: actions[0],
+		}
+		_, err = cl.ControlCollectionScan(context.Background(), req)
+		if err != nil {
+			return
+		}
+
+		fmt.Printf("OK\n")
+		return
+	}
+
+	req := &m3uetcpb.ScanCollectionRequest{
+		Id:         id,
+		UpdateTags: c.Bool("update-tags"),
+	}
+
+	if c.Bool("follow") {
+		return followCollectionScan(ctx, cl, req)
+	}
+
 	_, err = cl.ScanCollection(context.Background(), req)
 	if err != nil {
 		return
@@ -370,6 +447,115 @@ func collectionScanAction(ctx context.Context, c *cli.Command) (err error) {
 	return
 }
 
+// followCollectionScan starts the given scan and keeps a progress line
+// updated until the scan is done.
+func followCollectionScan(ctx context.Context, cl m3uetcpb.CollectionSvcClient,
+	req *m3uetcpb.ScanCollectionRequest) error {
+
+	// subscribed beforehand, so that no progress is missed
+	stream, err := cl.SubscribeToCollectionStore(ctx, &m3uetcpb.Empty{})
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	var subscriptionID string
+	defer func() {
+		if subscriptionID == "" {
+			return
+		}
+		_, err := cl.UnsubscribeFromCollectionStore(
+			context.Background(),
+			&m3uetcpb.UnsubscribeFromCollectionStoreRequest{
+				SubscriptionId: subscriptionID,
+			},
+		)
+		onerror.Log(err)
+	}()
+
+	if _, err = cl.ScanCollection(ctx, req); err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	const barWidth = 30
+
+	fmt.Printf("Waiting for the scan to start")
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			fmt.Println()
+			if ctx.Err() != nil {
+				return nil
+			}
+			s := status.Convert(err)
+			return fmt.Errorf(s.Message())
+		}
+		subscriptionID = res.SubscriptionId
+
+		p := res.GetProgress()
+		if p == nil || p.CollectionId != req.Id {
+			continue
+		}
+
+		if res.Event == m3uetcpb.CollectionEvent_CE_SCANNING_DONE {
+			state := "Scanned"
+			if p.Canceled {
+				state = "Canceled"
+			}
+			fmt.Printf(
+				"\r\033[K%s %d/%d files: %s\n",
+				state,
+				p.Seen,
+				p.Total,
+				formatScanCounts(p),
+			)
+			return nil
+		}
+
+		var fraction float64
+		if p.Total > 0 {
+			fraction = min(1, float64(p.Seen)/float64(p.Total))
+		}
+		filled := int(fraction * barWidth)
+
+		state := ">"
+		eta := "--"
+		if p.Paused {
+			state = "="
+			eta = "paused"
+		} else if p.Eta > 0 {
+			eta = (time.Duration(p.Eta) * time.Nanosecond).Truncate(time.Second).String()
+		}
+		fmt.Printf(
+			"\r\033[K%s [%s%s] %d/%d  %s  ETA %s  %s",
+			state,
+			strings.Repeat("#", filled),
+			strings.Repeat("-", barWidth-filled),
+			p.Seen,
+			p.Total,
+			formatScanCounts(p),
+			eta,
+			filepath.Base(p.CurrentPath),
+		)
+	}
+}
+
+// formatScanCounts returns the outcome of the files seen so far, as text.
+func formatScanCounts(p *m3uetcpb.ScanProgress) string {
+	counts := fmt.Sprintf(
+		"%d added, %d updated, %d unchanged, %d failed",
+		p.Added,
+		p.Updated,
+		p.Unchanged,
+		p.Failed,
+	)
+	if p.Removed > 0 {
+		counts += fmt.Sprintf(", %d removed", p.Removed)
+	}
+	return counts
+}
+
 func collectionDiscoverActiion(ctx context.Context, c *cli.Command) (err error) {
 	if err = mustNotParseExtraArgs(c); err != nil {
 		return
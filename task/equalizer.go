@@ -0,0 +1,364 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
+	"github.com/rodaine/table"
+	"github.com/urfave/cli/v3"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	newEqualizerSvcClient = m3uetcpb.NewEqualizerSvcClient
+)
+
+// Equalizer defines the equalizer-related tasks.
+func Equalizer() *cli.Command {
+	return &cli.Command{
+		Name:        "equalizer",
+		Aliases:     []string{"eq"},
+		Category:    "Control",
+		Usage:       "Manages the equalizer presets",
+		Description: "Performs equalizer-related actions. When no subcommand is given, display list of presets.",
+		Before:      checkServerStatus,
+		Action:      equalizerAction,
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:    "json",
+				Aliases: []string{"j"},
+				Usage:   "output JSON",
+			},
+		},
+		Commands: []*cli.Command{
+			{
+				Name:        "activate",
+				Usage:       "Activates a preset",
+				ArgsUsage:   "ID|flat",
+				Description: "Activate the preset identified by `ID` globally or, if a perspective is given, for that perspective.",
+				Action:      equalizerActivateAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "persp",
+						Usage: "applies to `PERSPECTIVE`",
+					},
+				},
+			},
+			{
+				Name:        "add",
+				Usage:       "Adds preset",
+				Description: "Add preset, according to the given options.",
+				Action:      equalizerAddAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "name",
+						Usage:    "preset `NAME`",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "descr",
+						Usage: "preset `DESCRIPTION`",
+					},
+					&cli.StringFlag{
+						Name:     "bands",
+						Usage:    "comma-separated list of ten band `GAINS`, in dB (e.g.: \"4,3,-2,-3,-1,2,4,5,5,5\")",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "genre",
+						Usage: "apply preset to tracks of the given `GENRE`",
+					},
+				},
+			},
+			{
+				Name:        "remove",
+				Aliases:     []string{"rem"},
+				Usage:       "Removes preset",
+				ArgsUsage:   "ID",
+				Description: "Remove the preset identified by `ID`.",
+				Action:      equalizerRemoveAction,
+			},
+			{
+				Name:        "update",
+				Aliases:     []string{"upd"},
+				Usage:       "Updates preset",
+				Description: "Update preset according to the given options.",
+				Action:      equalizerUpdateAction,
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:     "id",
+						Usage:    "preset's existing `ID`",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "name",
+						Usage: "preset `NAME`",
+					},
+					&cli.StringFlag{
+						Name:  "descr",
+						Usage: "preset `DESCRIPTION`",
+					},
+					&cli.StringFlag{
+						Name:  "bands",
+						Usage: "comma-separated list of ten band `GAINS`, in dB",
+					},
+					&cli.StringFlag{
+						Name:  "genre",
+						Usage: "apply preset to tracks of the given `GENRE`",
+					},
+					&cli.BoolFlag{
+						Name:  "no-genre",
+						Usage: "do not apply preset to any genre",
+					},
+				},
+			},
+		},
+	}
+}
+
+func equalizerAction(ctx context.Context, c *cli.Command) (err error) {
+	if err = mustNotParseExtraArgs(c); err != nil {
+		return
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := newEqualizerSvcClient(cc)
+	res, err := cl.GetEqualizerPresets(context.Background(), &m3uetcpb.Empty{})
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	if c.Bool("json") {
+		var bv []byte
+		bv, err = json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return
+		}
+		fmt.Printf("\n%v\n", string(bv))
+		return
+	}
+
+	active := map[int64][]string{}
+	if res.ActiveId > 0 {
+		active[res.ActiveId] = append(active[res.ActiveId], "global")
+	}
+	for _, x := range res.PerspectivePresets {
+		name := strings.ToLower(x.Perspective.String())
+		active[x.PresetId] = append(active[x.PresetId], name)
+	}
+
+	tbl := table.New("ID", "Name", "Bands", "Genre", "Active")
+	for _, p := range res.Presets {
+		tbl.AddRow(
+			p.Id,
+			p.Name,
+			formatEqualizerBands(p.Bands),
+			p.Genre,
+			strings.Join(active[p.Id], ","),
+		)
+	}
+	tbl.Print()
+	return
+}
+
+func equalizerActivateAction(ctx context.Context, c *cli.Command) (err error) {
+	rest := c.Args().Slice()
+	if len(rest) != 1 {
+		err = fmt.Errorf("I need one ID or `flat`")
+		return
+	}
+
+	req := &m3uetcpb.ActivateEqualizerPresetRequest{}
+	if rest[0] != "flat" {
+		if req.Id, err = mustParseSingleID(c); err != nil {
+			return
+		}
+	}
+	if c.String("persp") != "" {
+		persp := getPerspectiveFromString(c.String("persp"))
+		req.Perspective = &persp
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := newEqualizerSvcClient(cc)
+	_, err = cl.ActivateEqualizerPreset(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return
+}
+
+func equalizerAddAction(ctx context.Context, c *cli.Command) (err error) {
+	if err = mustNotParseExtraArgs(c); err != nil {
+		return
+	}
+
+	bands, err := parseEqualizerBands(c.String("bands"))
+	if err != nil {
+		return
+	}
+
+	req := &m3uetcpb.AddEqualizerPresetRequest{
+		Preset: &m3uetcpb.EqualizerPreset{
+			Name:        c.String("name"),
+			Description: c.String("descr"),
+			Bands:       bands,
+			Genre:       c.String("genre"),
+		},
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := newEqualizerSvcClient(cc)
+	res, err := cl.AddEqualizerPreset(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("ID: %v\n", res.Id)
+	return
+}
+
+func equalizerRemoveAction(ctx context.Context, c *cli.Command) (err error) {
+	var id int64
+	if id, err = mustParseSingleID(c); err != nil {
+		return
+	}
+
+	req := &m3uetcpb.RemoveEqualizerPresetRequest{
+		Id: id,
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := newEqualizerSvcClient(cc)
+	_, err = cl.RemoveEqualizerPreset(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return
+}
+
+func equalizerUpdateAction(ctx context.Context, c *cli.Command) (err error) {
+	if err = mustNotParseExtraArgs(c); err != nil {
+		return
+	}
+
+	if c.Int("id") < 1 {
+		err = fmt.Errorf("I need an ID greater than zero")
+		return
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return
+	}
+	defer cc.Close()
+
+	cl := newEqualizerSvcClient(cc)
+	res, err := cl.GetEqualizerPresets(context.Background(), &m3uetcpb.Empty{})
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	var p *m3uetcpb.EqualizerPreset
+	for _, x := range res.Presets {
+		if x.Id == c.Int("id") {
+			p = x
+			break
+		}
+	}
+	if p == nil {
+		err = fmt.Errorf("There is no preset with ID %v", c.Int("id"))
+		return
+	}
+
+	if c.String("name") != "" {
+		p.Name = c.String("name")
+	}
+
+	if c.String("descr") != "" {
+		p.Description = c.String("descr")
+	}
+
+	if c.String("bands") != "" {
+		if p.Bands, err = parseEqualizerBands(c.String("bands")); err != nil {
+			return
+		}
+	}
+
+	if c.String("genre") != "" {
+		p.Genre = c.String("genre")
+	}
+
+	if c.Bool("no-genre") {
+		p.Genre = ""
+	}
+
+	req := &m3uetcpb.UpdateEqualizerPresetRequest{
+		Preset: p,
+	}
+
+	_, err = cl.UpdateEqualizerPreset(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return
+}
+
+func formatEqualizerBands(bands []float64) string {
+	s := []string{}
+	for _, b := range bands {
+		s = append(s, strconv.FormatFloat(b, 'f', -1, 64))
+	}
+	return strings.Join(s, ",")
+}
+
+func parseEqualizerBands(s string) (bands []float64, err error) {
+	for _, x := range strings.Split(s, ",") {
+		var g float64
+		if g, err = strconv.ParseFloat(strings.TrimSpace(x), 64); err != nil {
+			err = fmt.Errorf("Found invalid band gain: %v", x)
+			return
+		}
+		bands = append(bands, g)
+	}
+	if len(bands) != 10 {
+		err = fmt.Errorf("I need ten band gains, got %v", len(bands))
+	}
+	return
+}
@@ -5,13 +5,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/jwmwalrus/bnp/urlstr"
 	"github.com/jwmwalrus/m3u-etcetera/api/m3uetcpb"
 	"github.com/rodaine/table"
 	"github.com/urfave/cli/v3"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var (
@@ -34,6 +37,11 @@ func Playback() *cli.Command {
 				Aliases: []string{"j"},
 				Usage:   "Output JSON",
 			},
+			&cli.BoolFlag{
+				Name:    "follow",
+				Aliases: []string{"f"},
+				Usage:   "Keep displaying the playback progress",
+			},
 		},
 		Commands: []*cli.Command{
 			{
@@ -52,6 +60,10 @@ func Playback() *cli.Command {
 						Name:  "ids",
 						Usage: "use IDs instead of LOCATIONs",
 					},
+					&cli.BoolFlag{
+						Name:  "from-beginning",
+						Usage: "ignore the saved resume position",
+					},
 				},
 			},
 			{
@@ -72,6 +84,12 @@ func Playback() *cli.Command {
 				Usage:       "Next in playback",
 				Description: "Play next track.",
 				Action:      playbackPlayAction,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "from-beginning",
+						Usage: "ignore the saved resume position",
+					},
+				},
 			},
 			{
 				Name:        "previous",
@@ -79,6 +97,12 @@ func Playback() *cli.Command {
 				Usage:       "Previous in playback",
 				Description: "Play previous track.",
 				Action:      playbackPlayAction,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "from-beginning",
+						Usage: "ignore the saved resume position",
+					},
+				},
 			},
 			{
 				Name:        "seek",
@@ -87,6 +111,195 @@ func Playback() *cli.Command {
 				Description: "Seek `POSITION` (seconds) in the current playback stream.",
 				Action:      playbackSeekAction,
 			},
+			{
+				Name:        "crossfade",
+				Aliases:     []string{"xf"},
+				Usage:       "Toggles crossfade",
+				ArgsUsage:   "on|off",
+				Description: "Enable (`on`) or disable (`off`) crossfading between tracks.",
+				Action:      playbackCrossfadeAction,
+			},
+			{
+				Name:        "rate",
+				Usage:       "Sets the playback rate",
+				ArgsUsage:   "RATE",
+				Description: "Set the playback `RATE` (e.g., 1.25), keeping the pitch.",
+				Action:      playbackRateAction,
+			},
+			{
+				Name:        "repeat",
+				Usage:       "Sets the repeat mode",
+				ArgsUsage:   "none|track|playlist",
+				Description: "Set the repeat mode for the given perspective.",
+				Action:      playbackRepeatAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "persp",
+						Usage: "applies to `PERSPECTIVE`",
+						Value: "music",
+					},
+				},
+			},
+			{
+				Name:        "shuffle",
+				Usage:       "Sets the shuffle mode",
+				ArgsUsage:   "on|off",
+				Description: "Enable or disable shuffle for the given perspective.",
+				Action:      playbackShuffleAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "persp",
+						Usage: "applies to `PERSPECTIVE`",
+						Value: "music",
+					},
+				},
+			},
+			{
+				Name:        "sleep",
+				Usage:       "Shows or sets the sleep timer",
+				ArgsUsage:   "[MINUTES|track|chapter|playlist|queue|off]",
+				Description: "Stop playback after the given number of `MINUTES`, or at the end of the current track, chapter, playlist or queue. If no argument is given, display the current sleep timer.",
+				Action:      playbackSleepAction,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "fade",
+						Usage: "fade out before going to sleep",
+					},
+					&cli.BoolFlag{
+						Name:  "pause",
+						Usage: "pause instead of stopping",
+					},
+				},
+			},
+			{
+				Name:        "volume",
+				Aliases:     []string{"vol"},
+				Usage:       "Shows or sets the volume",
+				ArgsUsage:   "[LEVEL]",
+				Description: "Set the volume to `LEVEL` (0-100) or, if no level is given, display the current volume.",
+				Action:      playbackVolumeAction,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "mute",
+						Usage: "mute playback",
+					},
+					&cli.BoolFlag{
+						Name:  "unmute",
+						Usage: "unmute playback",
+					},
+				},
+			},
+			{
+				Name:        "output",
+				Aliases:     []string{"out"},
+				Usage:       "Shows or sets the output device",
+				ArgsUsage:   "[SINK [DEVICE]]",
+				Description: "Route playback to the given `SINK` (auto, fake, file or a GStreamer sink, e.g., pulsesink) and, optionally, `DEVICE` (the output path, for the file sink). If no sink is given, display the available outputs.",
+				Action:      playbackOutputAction,
+			},
+			{
+				Name:        "broadcast",
+				Aliases:     []string{"bc"},
+				Usage:       "Shows, starts or stops the broadcast",
+				ArgsUsage:   "[start|stop]",
+				Description: "Start or stop serving the playback over HTTP. If no argument is given, display the broadcast status and listener count.",
+				Action:      playbackBroadcastAction,
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "format",
+						Usage: "broadcast `FORMAT` (opus or mp3)",
+					},
+					&cli.IntFlag{
+						Name:  "bitrate",
+						Usage: "broadcast `BITRATE`, in kbps",
+					},
+				},
+			},
+			{
+				Name:        "failures",
+				Aliases:     []string{"fail"},
+				Usage:       "Manages the locations that failed to play",
+				Description: "Retry or clear the locations that failed to play and were skipped. If no subcommand is given, list them along with the reason.",
+				Action:      playbackFailuresAction,
+				Commands: []*cli.Command{
+					{
+						Name:        "retry",
+						Usage:       "Retries failed locations",
+						ArgsUsage:   "[ID ...]",
+						Description: "Add the failed locations identified by ID, or all of them if no ID is given, back to the playback.",
+						Action:      playbackFailuresRetryAction,
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  "force",
+								Usage: "play immediately instead of after the current stream",
+							},
+						},
+					},
+					{
+						Name:        "clear",
+						Usage:       "Clears failed locations",
+						ArgsUsage:   "[ID ...]",
+						Description: "Forget the failed locations identified by ID, or all of them if no ID is given.",
+						Action:      playbackFailuresClearAction,
+					},
+				},
+			},
+			{
+				Name:        "scrobbles",
+				Aliases:     []string{"scrob"},
+				Usage:       "Manages the scrobbles outbox",
+				Description: "List the plays kept for the scrobbling service, newest first, and whether they were submitted.",
+				Action:      playbackScrobblesAction,
+				Flags: []cli.Flag{
+					&cli.BoolFlag{
+						Name:  "pending",
+						Usage: "list only those not submitted",
+					},
+					&cli.IntFlag{
+						Name:  "limit",
+						Usage: "list up to `LIMIT` scrobbles",
+					},
+				},
+				Commands: []*cli.Command{
+					{
+						Name:        "submit",
+						Usage:       "Submits pending scrobbles",
+						Description: "Submit the pending scrobbles right away, instead of waiting for the next retry.",
+						Action:      playbackScrobblesSubmitAction,
+					},
+					{
+						Name:        "export",
+						Aliases:     []string{"exp"},
+						Usage:       "Exports scrobbles",
+						ArgsUsage:   "LOCATION",
+						Description: "Export the pending scrobbles to a .scrobbler.log file at the given `LOCATION`, e.g., to submit them by other means.",
+						Action:      playbackScrobblesExportAction,
+						Flags: []cli.Flag{
+							&cli.BoolFlag{
+								Name:  "all",
+								Usage: "include those already submitted",
+							},
+							&cli.BoolFlag{
+								Name:  "mark",
+								Usage: "mark the exported scrobbles as submitted",
+							},
+						},
+					},
+				},
+			},
+			{
+				Name:        "heard",
+				Usage:       "Lists the titles heard in radio stations",
+				ArgsUsage:   "[LOCATION]",
+				Description: "List the titles reported by the given station, or by all stations if no LOCATION is given, newest first. The list is kept only if enabled in the server configuration.",
+				Action:      playbackHeardAction,
+				Flags: []cli.Flag{
+					&cli.IntFlag{
+						Name:  "limit",
+						Usage: "list up to `LIMIT` titles",
+					},
+				},
+			},
 			{
 				Name:        "list",
 				Aliases:     []string{"l"},
@@ -110,6 +323,11 @@ func playbackAction(ctx context.Context, c *cli.Command) (err error) {
 	defer cc.Close()
 
 	cl := newPlaybackSvcClient(cc)
+
+	if c.Bool("follow") {
+		return followPlayback(ctx, cl)
+	}
+
 	res, err := cl.GetPlayback(context.Background(), &m3uetcpb.Empty{})
 	if err != nil {
 		return
@@ -146,21 +364,116 @@ func playbackAction(ctx context.Context, c *cli.Command) (err error) {
 		artist = res.Track.Albumartist
 	}
 
-	dur := time.Duration(res.Track.Duration) * time.Nanosecond
-
-	tbl := table.New("ID", "Title", "Artist", "Album", "Duration")
+	tbl := table.New("ID", "Title", "Artist", "Album", "Position")
 	tbl.AddRow(
 		res.Track.Id,
 		res.Track.Title,
 		artist,
 		res.Track.Album,
-		dur.Truncate(time.Second).String(),
+		formatProgress(res.Progress, res.Track.Duration),
 	)
 	tbl.Print()
 
+	if si := res.StreamInfo; si != nil {
+		fmt.Println()
+		tbl := table.New("Station", "Bitrate")
+		tbl.AddRow(si.Organization, fmt.Sprintf("%d kbps", si.Bitrate))
+		tbl.Print()
+	}
+
 	return
 }
 
+// followPlayback subscribes to the playback and keeps a progress line
+// updated until the subscription ends.
+func followPlayback(ctx context.Context, cl m3uetcpb.PlaybackSvcClient) error {
+	stream, err := cl.SubscribeToPlayback(ctx, &m3uetcpb.Empty{})
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	const barWidth = 30
+
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			fmt.Println()
+			if ctx.Err() != nil {
+				return nil
+			}
+			s := status.Convert(err)
+			return fmt.Errorf(s.Message())
+		}
+
+		if pf := res.Failure; res.Event == m3uetcpb.PlaybackEvent_PE_ERROR && pf != nil {
+			fmt.Printf(
+				"\r\033[KSkipped %v (%v): %v\n",
+				pf.Location,
+				strings.ToLower(strings.TrimPrefix(pf.Reason.String(), "PF_")),
+				pf.Message,
+			)
+			continue
+		}
+
+		if !res.IsStreaming || res.Track == nil {
+			fmt.Printf("\r\033[KNot Playing")
+			continue
+		}
+
+		title := res.Track.Title
+		if title == "" {
+			title = res.Playback.Location
+		}
+		if res.Track.Artist != "" {
+			title += " - " + res.Track.Artist
+		}
+
+		var fraction float64
+		prog := res.Progress
+		if prog != nil && prog.Buffering < 100 {
+			fraction = float64(prog.Buffering) / 100
+		} else if prog != nil && prog.Duration > 0 && !prog.Live {
+			fraction = min(1, float64(prog.Position)/float64(prog.Duration))
+		}
+		filled := int(fraction * barWidth)
+
+		state := ">"
+		if res.IsPaused {
+			state = "="
+		}
+		fmt.Printf(
+			"\r\033[K%s [%s%s] %s  %s",
+			state,
+			strings.Repeat("#", filled),
+			strings.Repeat("-", barWidth-filled),
+			formatProgress(prog, res.Track.Duration),
+			title,
+		)
+	}
+}
+
+// formatProgress returns the given progress as text, falling back to the
+// track's duration if the progress is unknown.
+func formatProgress(prog *m3uetcpb.PlaybackProgress, duration int64) string {
+	if prog == nil {
+		dur := time.Duration(duration) * time.Nanosecond
+		return dur.Truncate(time.Second).String()
+	}
+
+	pos := (time.Duration(prog.Position) * time.Nanosecond).Truncate(time.Second)
+	switch {
+	case prog.Buffering < 100:
+		return fmt.Sprintf("Buffering %d%%", prog.Buffering)
+	case prog.Live:
+		return pos.String() + " (live)"
+	case prog.Duration > 0:
+		duration = prog.Duration
+	}
+	dur := (time.Duration(duration) * time.Nanosecond).Truncate(time.Second)
+	return pos.String() + " / " + dur.String()
+}
+
 func playbackPlayAction(ctx context.Context, c *cli.Command) (err error) {
 	const actionPrefix = "PB_"
 
@@ -189,6 +502,7 @@ func playbackPlayAction(ctx context.Context, c *cli.Command) (err error) {
 			return
 		}
 	}
+	req.FromBeginning = c.Bool("from-beginning")
 
 	cc, err := getClientConn()
 	if err != nil {
@@ -243,6 +557,715 @@ func playbackSeekAction(ctx context.Context, c *cli.Command) error {
 	return nil
 }
 
+func playbackCrossfadeAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) < 1 {
+		return fmt.Errorf("I need one of on or off")
+	}
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	req := &m3uetcpb.ExecutePlaybackActionRequest{
+		Action: m3uetcpb.PlaybackAction_PB_CROSSFADE,
+	}
+
+	switch strings.ToLower(rest[0]) {
+	case "on":
+		req.Crossfade = true
+	case "off":
+		req.Crossfade = false
+	default:
+		return fmt.Errorf("Invalid value for crossfade: %v", rest[0])
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.ExecutePlaybackAction(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackRateAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) < 1 {
+		return fmt.Errorf("I need one RATE")
+	}
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	req := &m3uetcpb.ExecutePlaybackActionRequest{
+		Action: m3uetcpb.PlaybackAction_PB_RATE,
+	}
+
+	var err error
+	if req.Rate, err = strconv.ParseFloat(rest[0], 64); err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.ExecutePlaybackAction(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackRepeatAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) < 1 {
+		return fmt.Errorf("I need one of none, track or playlist")
+	}
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	repeat, ok := m3uetcpb.RepeatMode_value["REPEAT_"+strings.ToUpper(rest[0])]
+	if !ok {
+		return fmt.Errorf("Invalid repeat mode: %v", rest[0])
+	}
+
+	req := &m3uetcpb.ExecutePlaybackActionRequest{
+		Action:      m3uetcpb.PlaybackAction_PB_REPEAT,
+		Repeat:      m3uetcpb.RepeatMode(repeat),
+		Perspective: getPerspectiveFromString(c.String("persp")),
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.ExecutePlaybackAction(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackShuffleAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) < 1 {
+		return fmt.Errorf("I need one of on or off")
+	}
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	req := &m3uetcpb.ExecutePlaybackActionRequest{
+		Action:      m3uetcpb.PlaybackAction_PB_SHUFFLE,
+		Perspective: getPerspectiveFromString(c.String("persp")),
+	}
+
+	switch strings.ToLower(rest[0]) {
+	case "on":
+		req.Shuffle = true
+	case "off":
+		req.Shuffle = false
+	default:
+		return fmt.Errorf("Invalid value for shuffle: %v", rest[0])
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.ExecutePlaybackAction(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackSleepAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+
+	if len(rest) == 0 {
+		res, err := cl.GetPlayback(context.Background(), &m3uetcpb.Empty{})
+		if err != nil {
+			s := status.Convert(err)
+			return fmt.Errorf(s.Message())
+		}
+
+		if c.Bool("json") {
+			bv, err := json.MarshalIndent(res.SleepTimer, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\n%v\n", string(bv))
+			return nil
+		}
+
+		st := res.SleepTimer
+		if st == nil || st.Mode == m3uetcpb.SleepMode_SLEEP_OFF {
+			fmt.Printf("\nThere is no sleep timer\n")
+			return nil
+		}
+
+		remaining := "-"
+		if st.Remaining > 0 {
+			remaining = (time.Duration(st.Remaining) * time.Nanosecond).
+				Truncate(time.Second).String()
+		}
+
+		tbl := table.New("Mode", "Remaining", "Fade Out", "Pause")
+		tbl.AddRow(
+			strings.TrimPrefix(st.Mode.String(), "SLEEP_"),
+			remaining,
+			st.FadeOut,
+			st.Pause,
+		)
+		tbl.Print()
+		return nil
+	}
+
+	req := &m3uetcpb.SetSleepTimerRequest{
+		FadeOut: c.Bool("fade"),
+		Pause:   c.Bool("pause"),
+	}
+
+	switch strings.ToLower(rest[0]) {
+	case "off":
+		req.Mode = m3uetcpb.SleepMode_SLEEP_OFF
+	case "track", "chapter":
+		req.Mode = m3uetcpb.SleepMode_SLEEP_END_OF_TRACK
+	case "playlist":
+		req.Mode = m3uetcpb.SleepMode_SLEEP_END_OF_PLAYLIST
+	case "queue":
+		req.Mode = m3uetcpb.SleepMode_SLEEP_END_OF_QUEUE
+	default:
+		minutes, err := strconv.ParseInt(rest[0], 10, 32)
+		if err != nil || minutes <= 0 {
+			return fmt.Errorf("Invalid value for sleep: %v", rest[0])
+		}
+		req.Mode = m3uetcpb.SleepMode_SLEEP_AFTER_MINUTES
+		req.Minutes = int32(minutes)
+	}
+
+	_, err = cl.SetSleepTimer(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackVolumeAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+	if c.Bool("mute") && c.Bool("unmute") {
+		return fmt.Errorf("Flags mute and unmute are mutually exclusive")
+	}
+
+	req := &m3uetcpb.SetVolumeRequest{}
+	if len(rest) == 1 {
+		level, err := strconv.ParseFloat(rest[0], 64)
+		if err != nil {
+			return err
+		}
+		if level < 0 || level > 100 {
+			return fmt.Errorf("LEVEL must be between 0 and 100")
+		}
+		req.Volume = proto.Float64(level / 100)
+	}
+	if c.Bool("mute") || c.Bool("unmute") {
+		req.Mute = proto.Bool(c.Bool("mute"))
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+
+	if req.Volume == nil && req.Mute == nil {
+		res, err := cl.GetVolume(context.Background(), &m3uetcpb.Empty{})
+		if err != nil {
+			s := status.Convert(err)
+			return fmt.Errorf(s.Message())
+		}
+
+		if c.Bool("json") {
+			bv, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\n%v\n", string(bv))
+			return nil
+		}
+
+		tbl := table.New("Volume", "Muted")
+		tbl.AddRow(fmt.Sprintf("%.0f%%", res.Volume*100), res.Mute)
+		tbl.Print()
+		return nil
+	}
+
+	_, err = cl.SetVolume(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackOutputAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) > 2 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+
+	if len(rest) == 0 {
+		res, err := cl.GetOutputDevices(context.Background(), &m3uetcpb.Empty{})
+		if err != nil {
+			s := status.Convert(err)
+			return fmt.Errorf(s.Message())
+		}
+
+		if c.Bool("json") {
+			bv, err := json.MarshalIndent(res, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\n%v\n", string(bv))
+			return nil
+		}
+
+		tbl := table.New("Sink", "Device", "Name", "Active")
+		for _, od := range res.Devices {
+			active := ""
+			if od.Sink == res.Active.GetSink() && od.Device == res.Active.GetDevice() {
+				active = "*"
+			}
+			tbl.AddRow(od.Sink, od.Device, od.Name, active)
+		}
+		tbl.Print()
+		return nil
+	}
+
+	req := &m3uetcpb.SetOutputDeviceRequest{Sink: rest[0]}
+	if len(rest) > 1 {
+		req.Device = rest[1]
+	}
+
+	_, err = cl.SetOutputDevice(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackBroadcastAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+
+	if len(rest) == 0 {
+		res, err := cl.GetBroadcast(context.Background(), &m3uetcpb.Empty{})
+		if err != nil {
+			s := status.Convert(err)
+			return fmt.Errorf(s.Message())
+		}
+
+		if c.Bool("json") {
+			bv, err := json.MarshalIndent(res.Broadcast, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Printf("\n%v\n", string(bv))
+			return nil
+		}
+
+		bc := res.Broadcast
+		if !bc.GetActive() {
+			fmt.Printf("\nThere is no active broadcast\n")
+			return nil
+		}
+
+		tbl := table.New("URL", "Format", "Bitrate", "Listeners", "Title")
+		tbl.AddRow(
+			bc.Url,
+			strings.TrimPrefix(bc.Format.String(), "BROADCAST_"),
+			bc.Bitrate,
+			bc.Listeners,
+			bc.Title,
+		)
+		tbl.Print()
+		return nil
+	}
+
+	switch strings.ToLower(rest[0]) {
+	case "start":
+		req := &m3uetcpb.StartBroadcastRequest{
+			Bitrate: int32(c.Int("bitrate")),
+		}
+		switch strings.ToLower(c.String("format")) {
+		case "":
+		case "opus", "ogg":
+			req.Format = m3uetcpb.BroadcastFormat_BROADCAST_OPUS
+		case "mp3":
+			req.Format = m3uetcpb.BroadcastFormat_BROADCAST_MP3
+		default:
+			return fmt.Errorf("Invalid value for format: %v", c.String("format"))
+		}
+		_, err = cl.StartBroadcast(context.Background(), req)
+	case "stop":
+		_, err = cl.StopBroadcast(context.Background(), &m3uetcpb.Empty{})
+	default:
+		return fmt.Errorf("Invalid value for broadcast: %v", rest[0])
+	}
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackFailuresAction(ctx context.Context, c *cli.Command) error {
+	if err := mustNotParseExtraArgs(c); err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	res, err := cl.GetPlaybackFailures(context.Background(), &m3uetcpb.Empty{})
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	if c.Bool("json") {
+		bv, err := json.MarshalIndent(res.Failures, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\n%v\n", string(bv))
+		return nil
+	}
+
+	if len(res.Failures) == 0 {
+		fmt.Printf("\nThere are no failed locations\n")
+		return nil
+	}
+
+	tbl := table.New("ID", "Location", "Reason", "Attempts", "Message")
+	for _, pf := range res.Failures {
+		un, _ := url.QueryUnescape(pf.Location)
+		if un == "" {
+			un = pf.Location
+		}
+		tbl.AddRow(
+			pf.Id,
+			un,
+			strings.ToLower(strings.TrimPrefix(pf.Reason.String(), "PF_")),
+			pf.Attempts,
+			pf.Message,
+		)
+	}
+	tbl.Print()
+	return nil
+}
+
+func playbackFailuresRetryAction(ctx context.Context, c *cli.Command) error {
+	ids, err := parseIDs(c.Args().Slice())
+	if err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.RetryPlaybackFailures(
+		context.Background(),
+		&m3uetcpb.RetryPlaybackFailuresRequest{
+			Ids:   ids,
+			Force: c.Bool("force"),
+		},
+	)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackFailuresClearAction(ctx context.Context, c *cli.Command) error {
+	ids, err := parseIDs(c.Args().Slice())
+	if err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.ClearPlaybackFailures(
+		context.Background(),
+		&m3uetcpb.ClearPlaybackFailuresRequest{Ids: ids},
+	)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackHeardAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) > 1 {
+		return fmt.Errorf("Too many values in command")
+	}
+
+	req := &m3uetcpb.GetStationHistoryRequest{
+		Limit: int32(c.Int("limit")),
+	}
+	if len(rest) > 0 {
+		req.Location = rest[0]
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	res, err := cl.GetStationHistory(context.Background(), req)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	if c.Bool("json") {
+		bv, err := json.MarshalIndent(res.Entries, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\n%v\n", string(bv))
+		return nil
+	}
+
+	if len(res.Entries) == 0 {
+		fmt.Printf("\nNothing heard yet\n")
+		return nil
+	}
+
+	tbl := table.New("Heard At", "Title", "Artist", "Station")
+	for _, e := range res.Entries {
+		tbl.AddRow(
+			e.CreatedAt.AsTime().Local().Format(time.DateTime),
+			e.Title,
+			e.Artist,
+			e.Organization,
+		)
+	}
+	tbl.Print()
+	return nil
+}
+
+func playbackScrobblesAction(ctx context.Context, c *cli.Command) error {
+	if err := mustNotParseExtraArgs(c); err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	res, err := cl.GetScrobbles(
+		context.Background(),
+		&m3uetcpb.GetScrobblesRequest{
+			Pending: c.Bool("pending"),
+			Limit:   int32(c.Int("limit")),
+		},
+	)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	if c.Bool("json") {
+		bv, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("\n%v\n", string(bv))
+		return nil
+	}
+
+	if len(res.Scrobbles) == 0 {
+		fmt.Printf("\nThere are no scrobbles\n")
+		return nil
+	}
+
+	tbl := table.New("ID", "Played At", "Title", "Artist", "Submitted", "Attempts", "Error")
+	for _, s := range res.Scrobbles {
+		submitted := "no"
+		if s.SubmittedAt != nil {
+			submitted = s.SubmittedAt.AsTime().Local().Format(time.DateTime)
+		}
+		tbl.AddRow(
+			s.Id,
+			s.PlayedAt.AsTime().Local().Format(time.DateTime),
+			s.Title,
+			s.Artist,
+			submitted,
+			s.Attempts,
+			s.LastError,
+		)
+	}
+	tbl.Print()
+	fmt.Printf("\nPending: %d\n", res.Pending)
+	return nil
+}
+
+func playbackScrobblesSubmitAction(ctx context.Context, c *cli.Command) error {
+	if err := mustNotParseExtraArgs(c); err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	_, err = cl.SubmitScrobbles(context.Background(), &m3uetcpb.Empty{})
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("OK\n")
+	return nil
+}
+
+func playbackScrobblesExportAction(ctx context.Context, c *cli.Command) error {
+	rest := c.Args().Slice()
+	if len(rest) != 1 {
+		return fmt.Errorf("I need a location")
+	}
+
+	location, err := urlstr.PathToURLUnchecked(rest[0])
+	if err != nil {
+		return err
+	}
+
+	cc, err := getClientConn()
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+
+	cl := newPlaybackSvcClient(cc)
+	res, err := cl.ExportScrobbles(
+		context.Background(),
+		&m3uetcpb.ExportScrobblesRequest{
+			Location:      location,
+			All:           c.Bool("all"),
+			MarkSubmitted: c.Bool("mark"),
+		},
+	)
+	if err != nil {
+		s := status.Convert(err)
+		return fmt.Errorf(s.Message())
+	}
+
+	fmt.Printf("Exported %d scrobbles\n", res.Count)
+	return nil
+}
+
 func playbackListAction(ctx context.Context, c *cli.Command) (err error) {
 	if err = mustNotParseExtraArgs(c); err != nil {
 		return